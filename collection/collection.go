@@ -1,8 +1,22 @@
 package collection
 
+import "iter"
+
 // Collectioner defines the needed methods to implement a collection.
 type Collectioner[T any] interface {
 	Add(value T)
 	Length() int
 	Remove(index int)
 }
+
+// Iterable defines a structure whose values can be visited without changing it. All returns an iter.Seq, so an
+// Iterable can be ranged over in a for loop or handed to anything that takes a sequence, such as a stream.
+type Iterable[T any] interface {
+	All() iter.Seq[T]
+}
+
+// Reversible defines an Iterable whose values can also be visited in reverse order.
+type Reversible[T any] interface {
+	Iterable[T]
+	Backward() iter.Seq[T]
+}
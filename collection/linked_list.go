@@ -0,0 +1,282 @@
+package collection
+
+import "iter"
+
+// ensure LinkedList satisfies the Collectioner and Reversible interfaces at compile time
+var (
+	_ Collectioner[any] = (*LinkedList[any])(nil)
+	_ Reversible[any]   = (*LinkedList[any])(nil)
+)
+
+// LinkedList defines a doubly linked list of any data. Adding a value returns its Element, a handle that can later be
+// used to insert next to, move or remove that value in O(1). Reaching a value by its index walks the list in O(n).
+type LinkedList[T any] struct {
+	root   Element[T] // sentinel element; root.next is the front of the list and root.prev is the back
+	length int
+}
+
+// Element is a handle to a value in a LinkedList.
+type Element[T any] struct {
+	Value T
+
+	prev *Element[T]
+	next *Element[T]
+	list *LinkedList[T] // the list the element belongs to; nil once it has been removed
+}
+
+// Next returns the element after this one, or nil at the back of the list.
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil || e.next == &e.list.root {
+		return nil
+	}
+
+	return e.next
+}
+
+// Prev returns the element before this one, or nil at the front of the list.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil || e.prev == &e.list.root {
+		return nil
+	}
+
+	return e.prev
+}
+
+// NewLinkedList constructs a new, empty linked list with the given type T.
+func NewLinkedList[T any]() *LinkedList[T] {
+	l := &LinkedList[T]{}
+	l.init()
+
+	return l
+}
+
+// init sets up the empty ring of elements.
+func (l *LinkedList[T]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.length = 0
+}
+
+// lazyInit initializes the list in the case that an empty struct was used.
+func (l *LinkedList[T]) lazyInit() {
+	if l.root.next == nil {
+		l.init()
+	}
+}
+
+// Empty removes all elements from the LinkedList and reduces its size to 0.
+func (l *LinkedList[T]) Empty() {
+	// detach every element so stale handles can no longer reach into the list
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		e.prev, e.next, e.list = nil, nil, nil
+		e = next
+	}
+
+	l.init()
+}
+
+// Length returns the number of elements in the LinkedList.
+func (l *LinkedList[T]) Length() int {
+	return l.length
+}
+
+// Front returns the first element of the list, or nil when the list is empty.
+func (l *LinkedList[T]) Front() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+
+	return l.root.next
+}
+
+// Back returns the last element of the list, or nil when the list is empty.
+func (l *LinkedList[T]) Back() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+// Add appends a new value to the back of the list.
+func (l *LinkedList[T]) Add(value T) {
+	l.PushBack(value)
+}
+
+// PushFront adds the value to the front of the list and returns its element.
+func (l *LinkedList[T]) PushFront(value T) *Element[T] {
+	l.lazyInit()
+	return l.insertAfter(&Element[T]{Value: value}, &l.root)
+}
+
+// PushBack adds the value to the back of the list and returns its element.
+func (l *LinkedList[T]) PushBack(value T) *Element[T] {
+	l.lazyInit()
+	return l.insertAfter(&Element[T]{Value: value}, l.root.prev)
+}
+
+// InsertBefore adds the value just before mark and returns its element. It returns nil when mark is not an element
+// of the list.
+func (l *LinkedList[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insertAfter(&Element[T]{Value: value}, mark.prev)
+}
+
+// InsertAfter adds the value just after mark and returns its element. It returns nil when mark is not an element of
+// the list.
+func (l *LinkedList[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insertAfter(&Element[T]{Value: value}, mark)
+}
+
+// Remove will take out the element at the given index from the LinkedList.
+func (l *LinkedList[T]) Remove(index int) {
+	// properly panic for index out of bounds
+	if index < 0 || index >= l.length {
+		panic("linked list: index out of bounds")
+	}
+
+	l.RemoveElement(l.elementAt(index))
+}
+
+// RemoveElement takes the element out of the list and returns its value. Removing an element that is not in the list
+// leaves the list untouched.
+func (l *LinkedList[T]) RemoveElement(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+
+	return e.Value
+}
+
+// Get returns the value at the given index, walking from whichever end of the list is closer.
+func (l *LinkedList[T]) Get(index int) T {
+	// properly panic for index out of bounds
+	if index < 0 || index >= l.length {
+		panic("linked list: index out of bounds")
+	}
+
+	return l.elementAt(index).Value
+}
+
+// MoveToFront moves the element to the front of the list. An element that is not in the list is ignored.
+func (l *LinkedList[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+
+	l.insertAfter(l.unlink(e), &l.root)
+}
+
+// MoveToBack moves the element to the back of the list. An element that is not in the list is ignored.
+func (l *LinkedList[T]) MoveToBack(e *Element[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+
+	l.insertAfter(l.unlink(e), l.root.prev)
+}
+
+// MoveBefore moves the element to just before mark. It is ignored when either is not in the list or they are the same
+// element.
+func (l *LinkedList[T]) MoveBefore(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+
+	// unlink first, as mark.prev is e itself when e already sits just before mark
+	l.unlink(e)
+	l.insertAfter(e, mark.prev)
+}
+
+// MoveAfter moves the element to just after mark. It is ignored when either is not in the list or they are the same
+// element.
+func (l *LinkedList[T]) MoveAfter(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+
+	l.insertAfter(l.unlink(e), mark)
+}
+
+// ToSlice returns the values of the LinkedList in order from front to back.
+func (l *LinkedList[T]) ToSlice() []T {
+	values := make([]T, 0, l.length)
+	for e := l.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value)
+	}
+
+	return values
+}
+
+// All returns an iterator over the values of the LinkedList from front to back. The list must not be changed while
+// iterating over it.
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the LinkedList from back to front. The list must not be changed
+// while iterating over it.
+func (l *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// elementAt returns the element at the given index, which must be in bounds.
+func (l *LinkedList[T]) elementAt(index int) *Element[T] {
+	if index < l.length/2 {
+		e := l.root.next
+		for i := 0; i < index; i++ {
+			e = e.next
+		}
+		return e
+	}
+
+	e := l.root.prev
+	for i := l.length - 1; i > index; i-- {
+		e = e.prev
+	}
+	return e
+}
+
+// insertAfter links the element into the list just after mark and returns it.
+func (l *LinkedList[T]) insertAfter(e, mark *Element[T]) *Element[T] {
+	e.prev = mark
+	e.next = mark.next
+	mark.next.prev = e
+	mark.next = e
+	e.list = l
+	l.length++
+
+	return e
+}
+
+// unlink takes the element out of the list and returns it.
+func (l *LinkedList[T]) unlink(e *Element[T]) *Element[T] {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev = nil
+	e.next = nil
+	e.list = nil
+	l.length--
+
+	return e
+}
@@ -0,0 +1,351 @@
+package collection
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+func TestLinkedList_Push(t *testing.T) {
+	type testScenario struct {
+		name           string
+		startingList   *LinkedList[int]
+		pushFront      []int
+		pushBack       []int
+		expectedValues []int
+	}
+
+	listWithValues := NewLinkedList[int]()
+	listWithValues.PushBack(2)
+	listWithValues.PushBack(3)
+
+	testScenarios := []testScenario{
+		{
+			name:           "push back onto empty struct",
+			startingList:   &LinkedList[int]{}, // create empty struct without constructor
+			pushBack:       []int{1, 2},
+			expectedValues: []int{1, 2},
+		},
+		{
+			name:           "push front onto empty struct",
+			startingList:   &LinkedList[int]{},
+			pushFront:      []int{1, 2},
+			expectedValues: []int{2, 1},
+		},
+		{
+			name:           "push onto both ends",
+			startingList:   listWithValues,
+			pushFront:      []int{1},
+			pushBack:       []int{4},
+			expectedValues: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.pushFront {
+				ts.startingList.PushFront(value)
+			}
+			for _, value := range ts.pushBack {
+				ts.startingList.PushBack(value)
+			}
+
+			if actualValues := ts.startingList.ToSlice(); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+}
+
+func TestLinkedList_Insert(t *testing.T) {
+	type testScenario struct {
+		name           string
+		markIndex      int
+		before         bool
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "insert before front", markIndex: 0, before: true, expectedValues: []int{9, 1, 2, 3}},
+		{name: "insert after front", markIndex: 0, expectedValues: []int{1, 9, 2, 3}},
+		{name: "insert before back", markIndex: 2, before: true, expectedValues: []int{1, 2, 9, 3}},
+		{name: "insert after back", markIndex: 2, expectedValues: []int{1, 2, 3, 9}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			l := NewLinkedList[int]()
+			elements := make([]*Element[int], 0)
+			for _, value := range []int{1, 2, 3} {
+				elements = append(elements, l.PushBack(value))
+			}
+
+			var inserted *Element[int]
+			if ts.before {
+				inserted = l.InsertBefore(9, elements[ts.markIndex])
+			} else {
+				inserted = l.InsertAfter(9, elements[ts.markIndex])
+			}
+
+			if inserted == nil || inserted.Value != 9 {
+				test.ReportTestFailure(t, inserted, 9)
+			}
+
+			if actualValues := l.ToSlice(); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			expectedBackward := slices.Clone(ts.expectedValues)
+			slices.Reverse(expectedBackward)
+			if actualBackward := slices.AppendSeq(make([]int, 0), l.Backward()); !cmp.Equal(actualBackward, expectedBackward) {
+				test.ReportTestFailure(t, actualBackward, expectedBackward)
+			}
+		})
+	}
+
+	t.Run("insert next to an element of another list", func(t *testing.T) {
+		l, other := NewLinkedList[int](), NewLinkedList[int]()
+		l.PushBack(1)
+		l.PushBack(2)
+
+		if inserted := l.InsertAfter(9, other.PushBack(3)); inserted != nil {
+			test.ReportTestFailure(t, inserted, nil)
+		}
+
+		if l.Length() != 2 {
+			test.ReportTestFailure(t, l.Length(), 2)
+		}
+	})
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+	type testScenario struct {
+		name           string
+		values         []int
+		index          int
+		byElement      bool
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "remove front by index", values: []int{1, 2, 3}, index: 0, expectedValues: []int{2, 3}},
+		{name: "remove back by index", values: []int{1, 2, 3}, index: 2, expectedValues: []int{1, 2}},
+		{name: "remove near back by index", values: []int{1, 2, 3, 4, 5}, index: 3, expectedValues: []int{1, 2, 3, 5}},
+		{name: "remove middle by element", values: []int{1, 2, 3}, index: 1, byElement: true, expectedValues: []int{1, 3}},
+		{name: "remove only element", values: []int{1}, index: 0, byElement: true, expectedValues: []int{}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			l := NewLinkedList[int]()
+			elements := make([]*Element[int], 0)
+			for _, value := range ts.values {
+				elements = append(elements, l.PushBack(value))
+			}
+
+			if ts.byElement {
+				if removed := l.RemoveElement(elements[ts.index]); removed != ts.values[ts.index] {
+					test.ReportTestFailure(t, removed, ts.values[ts.index])
+				}
+			} else {
+				l.Remove(ts.index)
+			}
+
+			if actualValues := l.ToSlice(); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			expectedBackward := slices.Clone(ts.expectedValues)
+			slices.Reverse(expectedBackward)
+			if actualBackward := slices.AppendSeq(make([]int, 0), l.Backward()); !cmp.Equal(actualBackward, expectedBackward) {
+				test.ReportTestFailure(t, actualBackward, expectedBackward)
+			}
+
+			if elements[ts.index].Next() != nil || elements[ts.index].Prev() != nil {
+				test.ReportTestFailure(t, elements[ts.index].Next(), nil)
+			}
+		})
+	}
+
+	t.Run("removing an element twice leaves the list untouched", func(t *testing.T) {
+		l := NewLinkedList[int]()
+		l.PushBack(1)
+		middle := l.PushBack(2)
+		l.PushBack(3)
+
+		l.RemoveElement(middle)
+		l.RemoveElement(middle)
+
+		if actualValues := l.ToSlice(); !cmp.Equal(actualValues, []int{1, 3}) {
+			test.ReportTestFailure(t, actualValues, []int{1, 3})
+		}
+
+		if l.Length() != 2 {
+			test.ReportTestFailure(t, l.Length(), 2)
+		}
+	})
+
+	t.Run("remove out of bounds panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				test.ReportTestFailure(t, r, "a panic for an index out of bounds")
+			}
+		}()
+
+		l := NewLinkedList[int]()
+		l.PushBack(1)
+		l.Remove(1)
+	})
+}
+
+func TestLinkedList_Get(t *testing.T) {
+	l := NewLinkedList[int]()
+	for value := 10; value <= 50; value += 10 {
+		l.PushBack(value)
+	}
+
+	for index, expected := range []int{10, 20, 30, 40, 50} {
+		if actual := l.Get(index); actual != expected {
+			test.ReportTestFailure(t, actual, expected)
+		}
+	}
+
+	t.Run("get out of bounds panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				test.ReportTestFailure(t, r, "a panic for an index out of bounds")
+			}
+		}()
+
+		l.Get(-1)
+	})
+}
+
+func TestLinkedList_Move(t *testing.T) {
+	type testScenario struct {
+		name           string
+		move           func(l *LinkedList[int], elements []*Element[int])
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:           "move back element to front",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveToFront(elements[3]) },
+			expectedValues: []int{4, 1, 2, 3},
+		},
+		{
+			name:           "move front element to front",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveToFront(elements[0]) },
+			expectedValues: []int{1, 2, 3, 4},
+		},
+		{
+			name:           "move front element to back",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveToBack(elements[0]) },
+			expectedValues: []int{2, 3, 4, 1},
+		},
+		{
+			name:           "move element before another",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveBefore(elements[3], elements[1]) },
+			expectedValues: []int{1, 4, 2, 3},
+		},
+		{
+			name:           "move element before the one it already precedes",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveBefore(elements[1], elements[2]) },
+			expectedValues: []int{1, 2, 3, 4},
+		},
+		{
+			name:           "move element after another",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveAfter(elements[0], elements[2]) },
+			expectedValues: []int{2, 3, 1, 4},
+		},
+		{
+			name:           "move element after itself",
+			move:           func(l *LinkedList[int], elements []*Element[int]) { l.MoveAfter(elements[1], elements[1]) },
+			expectedValues: []int{1, 2, 3, 4},
+		},
+		{
+			name: "move removed element",
+			move: func(l *LinkedList[int], elements []*Element[int]) {
+				l.RemoveElement(elements[1])
+				l.MoveToFront(elements[1])
+			},
+			expectedValues: []int{1, 3, 4},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			l := NewLinkedList[int]()
+			elements := make([]*Element[int], 0)
+			for _, value := range []int{1, 2, 3, 4} {
+				elements = append(elements, l.PushBack(value))
+			}
+
+			ts.move(l, elements)
+
+			if actualValues := l.ToSlice(); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			expectedBackward := slices.Clone(ts.expectedValues)
+			slices.Reverse(expectedBackward)
+			if actualBackward := slices.AppendSeq(make([]int, 0), l.Backward()); !cmp.Equal(actualBackward, expectedBackward) {
+				test.ReportTestFailure(t, actualBackward, expectedBackward)
+			}
+		})
+	}
+}
+
+func TestLinkedList_Empty(t *testing.T) {
+	l := NewLinkedList[int]()
+	front := l.PushBack(1)
+	l.PushBack(2)
+	l.Empty()
+
+	if l.Length() != 0 || l.Front() != nil || l.Back() != nil {
+		test.ReportTestFailure(t, l.Length(), 0)
+	}
+
+	// handles from before emptying no longer belong to the list
+	l.MoveToFront(front)
+	if l.Length() != 0 {
+		test.ReportTestFailure(t, l.Length(), 0)
+	}
+}
+
+func TestLinkedList_AllBackward(t *testing.T) {
+	l := NewLinkedList[int]()
+	for value := 1; value <= 4; value++ {
+		l.PushBack(value)
+	}
+
+	actualValues := make([]int, 0)
+	for value := range l.All() {
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues := []int{1, 2, 3, 4}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	actualValues = actualValues[:0]
+	for value := range l.Backward() {
+		if value < 3 {
+			break
+		}
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues = []int{4, 3}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	// an empty struct has nothing to visit
+	for value := range (&LinkedList[int]{}).All() {
+		test.ReportTestFailure(t, value, nil)
+	}
+}
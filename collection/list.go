@@ -1,18 +1,35 @@
 package collection
 
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
 //TODO: should this be of type comparable? or should comparabilty be handled in an iterator or similar?
 
-// List defines an unordered collection of any data.
+// ensure List satisfies the Collectioner and Reversible interfaces at compile time
+var (
+	_ Collectioner[any] = (*List[any])(nil)
+	_ Reversible[any]   = (*List[any])(nil)
+)
+
+// ErrIndexOutOfBounds is returned, wrapped with the offending index, by the Try methods of a List when an index falls
+// outside of the list. The methods without Try panic with it instead.
+var ErrIndexOutOfBounds = errors.New("list: index out of bounds")
+
+// List defines an unordered collection of any data. It is a dynamic array backed by a simple golang slice, so values
+// are reached by index in O(1) and adding or removing at the end is O(1), while inserting or removing elsewhere
+// shifts the values after it.
 type List[T any] struct {
 	coreSlice []T
-	size      int
 }
 
 // NewList constructs a new list with the given type T.
 func NewList[T any]() *List[T] {
 	return &List[T]{
 		coreSlice: make([]T, 0),
-		size:      0,
 	}
 }
 
@@ -20,67 +37,356 @@ func NewList[T any]() *List[T] {
 func NewListFromSlice[T any](slice []T) *List[T] {
 	return &List[T]{
 		coreSlice: slice,
-		size:      len(slice),
 	}
 }
 
 // Empty removes all elements from the List and reduces its size to 0.
 func (l *List[T]) Empty() {
-	l.size = 0
 	l.coreSlice = make([]T, 0)
 }
 
 // Add appends a new value.
 func (l *List[T]) Add(value T) {
 	l.coreSlice = append(l.coreSlice, value)
-	l.size++
 }
 
-// Remove will take out the element at the given index from the List.
-func (l *List[T]) Remove(index int) {
-	// properly panic for index out of bounds
-	if index < 0 || index > len(l.coreSlice) {
-		panic("list: index out of bounds")
+// Get returns the value at the given index. It panics when the index is out of bounds.
+func (l *List[T]) Get(index int) T {
+	value, err := l.TryGet(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// TryGet returns the value at the given index, or an error when the index is out of bounds.
+func (l *List[T]) TryGet(index int) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zero T
+		return zero, err
 	}
 
-	// make a new slice and replace rather than modifying the other one
-	newCoreSlice := make([]T, 0)
-	newCoreSlice = append(newCoreSlice, l.coreSlice[:index]...)
-	newCoreSlice = append(newCoreSlice, l.coreSlice[index+1:]...)
-	l.coreSlice = newCoreSlice
-	l.size = len(newCoreSlice)
+	return l.coreSlice[index], nil
 }
 
-// Set will add the value to the list at the given index.
+// Set replaces the value at the given index. It panics when the index is out of bounds.
+//
+// Deprecated: Set takes its arguments in the opposite order to every other method that takes an index. Use Replace
+// instead.
 func (l *List[T]) Set(value T, index int) {
-	// properly panic for index out of bounds
-	if index < 0 || index > len(l.coreSlice) {
-		panic("list: index out of bounds")
+	l.Replace(index, value)
+}
+
+// Replace replaces the value at the given index. It panics when the index is out of bounds.
+func (l *List[T]) Replace(index int, value T) {
+	if err := l.TryReplace(index, value); err != nil {
+		panic(err)
+	}
+}
+
+// TryReplace replaces the value at the given index, or returns an error when the index is out of bounds.
+func (l *List[T]) TryReplace(index int, value T) error {
+	if err := l.checkIndex(index); err != nil {
+		return err
+	}
+
+	l.coreSlice[index] = value
+	return nil
+}
+
+// Insert puts the value at the given index, shifting the value already there and everything after it back by one.
+// Inserting at the length of the list appends the value. It panics when the index is out of bounds.
+func (l *List[T]) Insert(index int, value T) {
+	if err := l.TryInsert(index, value); err != nil {
+		panic(err)
 	}
+}
+
+// TryInsert puts the value at the given index like Insert, or returns an error when the index is out of bounds.
+func (l *List[T]) TryInsert(index int, value T) error {
+	// the length itself is a valid place to insert, as that appends
+	if index != len(l.coreSlice) {
+		if err := l.checkIndex(index); err != nil {
+			return err
+		}
+	}
+
+	var zero T
+	l.coreSlice = append(l.coreSlice, zero)
+	copy(l.coreSlice[index+1:], l.coreSlice[index:])
+	l.coreSlice[index] = value
 
-	if index == 0 && len(l.coreSlice) == 0 {
-		l.coreSlice = append(l.coreSlice, value)
-	} else {
-		l.coreSlice[index] = value
+	return nil
+}
+
+// Remove will take out the element at the given index from the List. Removing the last element is O(1). It panics
+// when the index is out of bounds.
+func (l *List[T]) Remove(index int) {
+	if _, err := l.TryRemove(index); err != nil {
+		panic(err)
 	}
 }
 
-// Get will get the element at the given index.
-func (l *List[T]) Get(index int) any {
-	// properly panic for index out of bounds
-	if index < 0 || index > len(l.coreSlice) {
-		panic("list: index out of bounds")
+// TryRemove takes out the element at the given index and returns it, or returns an error when the index is out of
+// bounds.
+func (l *List[T]) TryRemove(index int) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zero T
+		return zero, err
 	}
 
-	return l.coreSlice[index]
+	removed := l.coreSlice[index]
+	l.removeRange(index, index+1)
+
+	return removed, nil
+}
+
+// RemoveRange takes out the elements from index from up to but not including index to. It panics when the range is
+// out of bounds or from is after to.
+func (l *List[T]) RemoveRange(from, to int) {
+	if err := l.TryRemoveRange(from, to); err != nil {
+		panic(err)
+	}
+}
+
+// TryRemoveRange takes out the elements from index from up to but not including index to, or returns an error when
+// the range is out of bounds or from is after to.
+func (l *List[T]) TryRemoveRange(from, to int) error {
+	if from < 0 || to > len(l.coreSlice) || from > to {
+		return fmt.Errorf("%w: range [%d, %d) with length %d", ErrIndexOutOfBounds, from, to, len(l.coreSlice))
+	}
+
+	l.removeRange(from, to)
+	return nil
+}
+
+// Swap exchanges the values at the two given indices. It panics when either index is out of bounds.
+func (l *List[T]) Swap(i, j int) {
+	if err := l.TrySwap(i, j); err != nil {
+		panic(err)
+	}
+}
+
+// TrySwap exchanges the values at the two given indices, or returns an error when either index is out of bounds.
+func (l *List[T]) TrySwap(i, j int) error {
+	if err := l.checkIndex(i); err != nil {
+		return err
+	}
+	if err := l.checkIndex(j); err != nil {
+		return err
+	}
+
+	l.coreSlice[i], l.coreSlice[j] = l.coreSlice[j], l.coreSlice[i]
+	return nil
 }
 
 // Length returns the size of the List.
 func (l *List[T]) Length() int {
-	return l.size
+	return len(l.coreSlice)
 }
 
-//TODO: things to consider
-// - basic iterator
-// - find element
-// - sort?
+// All returns an iterator over the values of the List from first to last. The List must not have values inserted or
+// removed while iterating over it.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range l.coreSlice {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the List from last to first. The List must not have values inserted
+// or removed while iterating over it.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(l.coreSlice) - 1; i >= 0; i-- {
+			if !yield(l.coreSlice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Cap returns the number of values the List can hold before it has to grow its underlying slice.
+func (l *List[T]) Cap() int {
+	return cap(l.coreSlice)
+}
+
+// Grow makes room for at least n more values, so that adding them does not grow the underlying slice again. It panics
+// when n is negative.
+func (l *List[T]) Grow(n int) {
+	if n < 0 {
+		panic("list: cannot grow by a negative number of values")
+	}
+
+	l.coreSlice = slices.Grow(l.coreSlice, n)
+}
+
+// Clip frees any capacity of the underlying slice that is beyond the length of the List.
+func (l *List[T]) Clip() {
+	if cap(l.coreSlice) == len(l.coreSlice) {
+		return
+	}
+
+	clipped := make([]T, len(l.coreSlice))
+	copy(clipped, l.coreSlice)
+	l.coreSlice = clipped
+}
+
+// removeRange takes out the elements in [from, to) in place, clearing the vacated slots at the end so they can be
+// garbage collected.
+func (l *List[T]) removeRange(from, to int) {
+	if from == to {
+		return
+	}
+
+	n := copy(l.coreSlice[from:], l.coreSlice[to:])
+	clear(l.coreSlice[from+n:])
+	l.coreSlice = l.coreSlice[:from+n]
+}
+
+// checkIndex returns an error when the index does not refer to a value in the List.
+func (l *List[T]) checkIndex(index int) error {
+	if index < 0 || index >= len(l.coreSlice) {
+		return fmt.Errorf("%w: index %d with length %d", ErrIndexOutOfBounds, index, len(l.coreSlice))
+	}
+
+	return nil
+}
+
+// IndexOfFunc returns the index of the first value that equal reports as equal to the given value, or -1 when there is
+// none. This allows searching lists of values that are not comparable.
+func (l *List[T]) IndexOfFunc(value T, equal func(a, b T) bool) int {
+	for i, v := range l.coreSlice {
+		if equal(v, value) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ContainsFunc reports whether the List holds a value that equal reports as equal to the given value.
+func (l *List[T]) ContainsFunc(value T, equal func(a, b T) bool) bool {
+	return l.IndexOfFunc(value, equal) >= 0
+}
+
+// SortFunc sorts the List in place in the order given by compare, which returns a negative number when a sorts before
+// b, a positive number when a sorts after b and zero when they are equal. Equal values may be reordered.
+func (l *List[T]) SortFunc(compare func(a, b T) int) {
+	slices.SortFunc(l.coreSlice, compare)
+}
+
+// SortStableFunc sorts the List in place like SortFunc, while keeping equal values in their original order.
+func (l *List[T]) SortStableFunc(compare func(a, b T) int) {
+	slices.SortStableFunc(l.coreSlice, compare)
+}
+
+// BinarySearchFunc searches a List sorted by compare for the target. It returns the index the target is at, or would
+// be inserted at to keep the List sorted, along with whether the target was found.
+func (l *List[T]) BinarySearchFunc(target T, compare func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(l.coreSlice, target, compare)
+}
+
+// Reverse reverses the order of the values in the List in place.
+func (l *List[T]) Reverse() {
+	slices.Reverse(l.coreSlice)
+}
+
+// Filter returns a new List holding, in order, only the values that keep reports true for. The List itself is left
+// untouched.
+func (l *List[T]) Filter(keep func(value T) bool) *List[T] {
+	filtered := NewList[T]()
+	for _, value := range l.coreSlice {
+		if keep(value) {
+			filtered.Add(value)
+		}
+	}
+
+	return filtered
+}
+
+// AnyMatch reports whether predicate is true for at least one value in the List. It is false for an empty List.
+func (l *List[T]) AnyMatch(predicate func(value T) bool) bool {
+	return slices.ContainsFunc(l.coreSlice, predicate)
+}
+
+// AllMatch reports whether predicate is true for every value in the List. It is true for an empty List.
+func (l *List[T]) AllMatch(predicate func(value T) bool) bool {
+	for _, value := range l.coreSlice {
+		if !predicate(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DedupFunc removes every value that equal reports as equal to a value before it, keeping the first of each in
+// place. It runs in O(n^2), so Dedup is faster for comparable values.
+func (l *List[T]) DedupFunc(equal func(a, b T) bool) {
+	kept := 0
+	for _, value := range l.coreSlice {
+		if !slices.ContainsFunc(l.coreSlice[:kept], func(k T) bool { return equal(k, value) }) {
+			l.coreSlice[kept] = value
+			kept++
+		}
+	}
+
+	clear(l.coreSlice[kept:])
+	l.coreSlice = l.coreSlice[:kept]
+}
+
+// IndexOf returns the index of the first occurrence of the value in the List, or -1 when it is not present.
+func IndexOf[T comparable](l *List[T], value T) int {
+	return slices.Index(l.coreSlice, value)
+}
+
+// Contains reports whether the value is in the List.
+func Contains[T comparable](l *List[T], value T) bool {
+	return IndexOf(l, value) >= 0
+}
+
+// Map returns a new List holding the result of fn for each value of the List, in order. It is a function rather than
+// a method because methods cannot introduce the new type U.
+func Map[T, U any](l *List[T], fn func(value T) U) *List[U] {
+	mapped := make([]U, 0, l.Length())
+	for _, value := range l.coreSlice {
+		mapped = append(mapped, fn(value))
+	}
+
+	return NewListFromSlice(mapped)
+}
+
+// Reduce folds the values of the List into a single result, starting from initial and calling fn with the result so
+// far and each value in order.
+func Reduce[T, A any](l *List[T], initial A, fn func(result A, value T) A) A {
+	result := initial
+	for _, value := range l.coreSlice {
+		result = fn(result, value)
+	}
+
+	return result
+}
+
+// Dedup removes repeated values from the List in place, keeping the first occurrence of each in order.
+func Dedup[T comparable](l *List[T]) {
+	seen := make(map[T]struct{}, l.Length())
+
+	kept := 0
+	for _, value := range l.coreSlice {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		l.coreSlice[kept] = value
+		kept++
+	}
+
+	clear(l.coreSlice[kept:])
+	l.coreSlice = l.coreSlice[:kept]
+}
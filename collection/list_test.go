@@ -1,8 +1,11 @@
 package collection
 
 import (
+	"errors"
 	"github.com/devsquared/gods/test"
 	"github.com/google/go-cmp/cmp"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -188,21 +191,34 @@ func TestList_Set(t *testing.T) {
 
 	testScenarios := []testScenario{
 		{
-			name:          "add an element",
+			name:          "replace the first element",
 			input:         "test",
 			indexToSet:    0,
-			expectedSlice: []string{"test"},
+			expectedSlice: []string{"test", "second", "third"},
+		},
+		{
+			name:          "replace the last element",
+			input:         "test",
+			indexToSet:    2,
+			expectedSlice: []string{"first", "second", "test"},
 		},
 	}
 
 	for _, scenario := range testScenarios {
 		t.Run(scenario.name, func(t *testing.T) {
-			list := NewList[string]()
+			list := NewListFromSlice([]string{"first", "second", "third"})
 			list.Set(scenario.input, scenario.indexToSet)
 
 			if !cmp.Equal(list.coreSlice, scenario.expectedSlice) {
 				test.ReportTestFailure(t, list.coreSlice, scenario.expectedSlice)
 			}
+
+			replaced := NewListFromSlice([]string{"first", "second", "third"})
+			replaced.Replace(scenario.indexToSet, scenario.input)
+
+			if !cmp.Equal(replaced.coreSlice, scenario.expectedSlice) {
+				test.ReportTestFailure(t, replaced.coreSlice, scenario.expectedSlice)
+			}
 		})
 	}
 }
@@ -247,3 +263,661 @@ func TestList_Get(t *testing.T) {
 	}
 
 }
+
+func TestList_IndexAtLengthPanics(t *testing.T) {
+	type testScenario struct {
+		name   string
+		action func(list *List[int])
+	}
+
+	testScenarios := []testScenario{
+		{name: "get at length", action: func(list *List[int]) { list.Get(2) }},
+		{name: "set at length", action: func(list *List[int]) { list.Set(0, 2) }},
+		{name: "replace at length", action: func(list *List[int]) { list.Replace(2, 0) }},
+		{name: "remove at length", action: func(list *List[int]) { list.Remove(2) }},
+		{name: "swap at length", action: func(list *List[int]) { list.Swap(0, 2) }},
+		{name: "insert past length", action: func(list *List[int]) { list.Insert(3, 0) }},
+		{name: "remove range past length", action: func(list *List[int]) { list.RemoveRange(1, 3) }},
+		{name: "grow by negative amount", action: func(list *List[int]) { list.Grow(-1) }},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					test.ReportTestFailure(t, r, "a panic for an index out of bounds")
+				}
+
+				// index errors are ours rather than the runtime's
+				if err, ok := r.(error); ok && !errors.Is(err, ErrIndexOutOfBounds) {
+					test.ReportTestFailure(t, err, ErrIndexOutOfBounds)
+				}
+			}()
+
+			ts.action(NewListFromSlice([]int{1, 2}))
+		})
+	}
+}
+
+func TestList_Try(t *testing.T) {
+	type testScenario struct {
+		name          string
+		action        func(list *List[int]) error
+		expectedErr   bool
+		expectedSlice []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name: "try get within bounds",
+			action: func(list *List[int]) error {
+				_, err := list.TryGet(1)
+				return err
+			},
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name: "try get out of bounds",
+			action: func(list *List[int]) error {
+				_, err := list.TryGet(3)
+				return err
+			},
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name:          "try replace out of bounds",
+			action:        func(list *List[int]) error { return list.TryReplace(-1, 9) },
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name:          "try insert at length",
+			action:        func(list *List[int]) error { return list.TryInsert(3, 9) },
+			expectedSlice: []int{1, 2, 3, 9},
+		},
+		{
+			name:          "try insert out of bounds",
+			action:        func(list *List[int]) error { return list.TryInsert(4, 9) },
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name: "try remove out of bounds",
+			action: func(list *List[int]) error {
+				_, err := list.TryRemove(-1)
+				return err
+			},
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name:          "try remove backwards range",
+			action:        func(list *List[int]) error { return list.TryRemoveRange(2, 1) },
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+		{
+			name:          "try swap out of bounds",
+			action:        func(list *List[int]) error { return list.TrySwap(0, 5) },
+			expectedErr:   true,
+			expectedSlice: []int{1, 2, 3},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice([]int{1, 2, 3})
+			err := ts.action(list)
+
+			if ts.expectedErr != errors.Is(err, ErrIndexOutOfBounds) || !ts.expectedErr && err != nil {
+				test.ReportTestFailure(t, err, ts.expectedErr)
+			}
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+
+	t.Run("try remove returns the removed value", func(t *testing.T) {
+		list := NewListFromSlice([]int{1, 2, 3})
+
+		removed, err := list.TryRemove(1)
+		if removed != 2 || err != nil {
+			test.ReportTestFailure(t, removed, 2)
+		}
+	})
+}
+
+func TestList_Insert(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []string
+		index         int
+		input         string
+		expectedSlice []string
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "insert into empty list",
+			startingSlice: []string{},
+			index:         0,
+			input:         "new",
+			expectedSlice: []string{"new"},
+		},
+		{
+			name:          "insert at front",
+			startingSlice: []string{"a", "b"},
+			index:         0,
+			input:         "new",
+			expectedSlice: []string{"new", "a", "b"},
+		},
+		{
+			name:          "insert in middle",
+			startingSlice: []string{"a", "b"},
+			index:         1,
+			input:         "new",
+			expectedSlice: []string{"a", "new", "b"},
+		},
+		{
+			name:          "insert at length appends",
+			startingSlice: []string{"a", "b"},
+			index:         2,
+			input:         "new",
+			expectedSlice: []string{"a", "b", "new"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+			list.Insert(ts.index, ts.input)
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+}
+
+func TestList_RemoveRange(t *testing.T) {
+	type testScenario struct {
+		name          string
+		from          int
+		to            int
+		expectedSlice []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "remove empty range", from: 2, to: 2, expectedSlice: []int{0, 1, 2, 3, 4}},
+		{name: "remove from front", from: 0, to: 2, expectedSlice: []int{2, 3, 4}},
+		{name: "remove from middle", from: 1, to: 4, expectedSlice: []int{0, 4}},
+		{name: "remove from end", from: 3, to: 5, expectedSlice: []int{0, 1, 2}},
+		{name: "remove everything", from: 0, to: 5, expectedSlice: []int{}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice([]int{0, 1, 2, 3, 4})
+			list.RemoveRange(ts.from, ts.to)
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+
+			if list.Length() != len(ts.expectedSlice) {
+				test.ReportTestFailure(t, list.Length(), len(ts.expectedSlice))
+			}
+		})
+	}
+
+	t.Run("removal clears the vacated slots", func(t *testing.T) {
+		value := 1
+		list := NewListFromSlice([]*int{&value, &value, &value})
+		list.RemoveRange(0, 2)
+
+		for i, slot := range list.coreSlice[:cap(list.coreSlice)] {
+			if i >= list.Length() && slot != nil {
+				test.ReportTestFailure(t, slot, nil)
+			}
+		}
+	})
+}
+
+func TestList_Swap(t *testing.T) {
+	list := NewListFromSlice([]string{"a", "b", "c"})
+	list.Swap(0, 2)
+	list.Swap(1, 1)
+
+	expectedSlice := []string{"c", "b", "a"}
+	if !cmp.Equal(list.coreSlice, expectedSlice) {
+		test.ReportTestFailure(t, list.coreSlice, expectedSlice)
+	}
+}
+
+func TestList_AllBackward(t *testing.T) {
+	type testScenario struct {
+		name             string
+		startingSlice    []int
+		expectedValues   []int
+		expectedBackward []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "iterate empty list", startingSlice: []int{}, expectedValues: []int{}, expectedBackward: []int{}},
+		{name: "iterate single value", startingSlice: []int{1}, expectedValues: []int{1}, expectedBackward: []int{1}},
+		{name: "iterate many values", startingSlice: []int{1, 2, 3}, expectedValues: []int{1, 2, 3}, expectedBackward: []int{3, 2, 1}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+
+			actualValues := make([]int, 0)
+			for value := range list.All() {
+				actualValues = append(actualValues, value)
+			}
+			if !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			actualBackward := make([]int, 0)
+			for value := range list.Backward() {
+				actualBackward = append(actualBackward, value)
+			}
+			if !cmp.Equal(actualBackward, ts.expectedBackward) {
+				test.ReportTestFailure(t, actualBackward, ts.expectedBackward)
+			}
+		})
+	}
+
+	t.Run("stop iterating early", func(t *testing.T) {
+		list := NewListFromSlice([]int{1, 2, 3, 4})
+
+		actualValues := make([]int, 0)
+		for value := range list.Backward() {
+			if value < 3 {
+				break
+			}
+			actualValues = append(actualValues, value)
+		}
+
+		expectedValues := []int{4, 3}
+		if !cmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+}
+
+func TestList_Capacity(t *testing.T) {
+	list := NewList[int]()
+
+	list.Grow(10)
+	if list.Cap() < 10 || list.Length() != 0 {
+		test.ReportTestFailure(t, list.Cap(), 10)
+	}
+
+	// adding within the grown capacity must not move the underlying slice
+	grown := list.Cap()
+	for i := 0; i < grown; i++ {
+		list.Add(i)
+	}
+	if list.Cap() != grown {
+		test.ReportTestFailure(t, list.Cap(), grown)
+	}
+
+	list.RemoveRange(2, list.Length())
+	list.Clip()
+	if list.Cap() != 2 {
+		test.ReportTestFailure(t, list.Cap(), 2)
+	}
+
+	expectedSlice := []int{0, 1}
+	if !cmp.Equal(list.coreSlice, expectedSlice) {
+		test.ReportTestFailure(t, list.coreSlice, expectedSlice)
+	}
+}
+
+// point is a value that is not comparable, to exercise the methods that take an equality func.
+type point struct {
+	coordinates []int
+}
+
+func equalPoints(a, b point) bool {
+	return slices.Equal(a.coordinates, b.coordinates)
+}
+
+func TestList_IndexOfContains(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []string
+		value         string
+		expectedIndex int
+	}
+
+	testScenarios := []testScenario{
+		{name: "search empty list", startingSlice: []string{}, value: "a", expectedIndex: -1},
+		{name: "search missing value", startingSlice: []string{"a", "b"}, value: "c", expectedIndex: -1},
+		{name: "search present value", startingSlice: []string{"a", "b", "c"}, value: "b", expectedIndex: 1},
+		{name: "search repeated value", startingSlice: []string{"a", "b", "a"}, value: "a", expectedIndex: 0},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+
+			if actualIndex := IndexOf(list, ts.value); actualIndex != ts.expectedIndex {
+				test.ReportTestFailure(t, actualIndex, ts.expectedIndex)
+			}
+
+			if actualContains := Contains(list, ts.value); actualContains != (ts.expectedIndex >= 0) {
+				test.ReportTestFailure(t, actualContains, ts.expectedIndex >= 0)
+			}
+		})
+	}
+}
+
+func TestList_IndexOfContainsFunc(t *testing.T) {
+	type testScenario struct {
+		name          string
+		value         point
+		expectedIndex int
+	}
+
+	list := NewListFromSlice([]point{{[]int{0, 0}}, {[]int{1, 2}}, {[]int{1, 2}}})
+
+	testScenarios := []testScenario{
+		{name: "search missing value", value: point{[]int{2, 1}}, expectedIndex: -1},
+		{name: "search present value", value: point{[]int{0, 0}}, expectedIndex: 0},
+		{name: "search repeated value", value: point{[]int{1, 2}}, expectedIndex: 1},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualIndex := list.IndexOfFunc(ts.value, equalPoints); actualIndex != ts.expectedIndex {
+				test.ReportTestFailure(t, actualIndex, ts.expectedIndex)
+			}
+
+			if actualContains := list.ContainsFunc(ts.value, equalPoints); actualContains != (ts.expectedIndex >= 0) {
+				test.ReportTestFailure(t, actualContains, ts.expectedIndex >= 0)
+			}
+		})
+	}
+}
+
+func TestList_Sort(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+
+	type testScenario struct {
+		name          string
+		startingSlice []pair
+		stable        bool
+		expectedSlice []pair
+	}
+
+	byKey := func(a, b pair) int { return a.key - b.key }
+
+	testScenarios := []testScenario{
+		{
+			name:          "sort empty list",
+			startingSlice: []pair{},
+			expectedSlice: []pair{},
+		},
+		{
+			name:          "sort distinct keys",
+			startingSlice: []pair{{3, "c"}, {1, "a"}, {2, "b"}},
+			expectedSlice: []pair{{1, "a"}, {2, "b"}, {3, "c"}},
+		},
+		{
+			name:          "stable sort keeps equal keys in order",
+			startingSlice: []pair{{2, "first"}, {1, "a"}, {2, "second"}, {0, "z"}, {2, "third"}},
+			stable:        true,
+			expectedSlice: []pair{{0, "z"}, {1, "a"}, {2, "first"}, {2, "second"}, {2, "third"}},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+
+			if ts.stable {
+				list.SortStableFunc(byKey)
+			} else {
+				list.SortFunc(byKey)
+			}
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice, cmp.AllowUnexported(pair{})) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+}
+
+func TestList_BinarySearchFunc(t *testing.T) {
+	type testScenario struct {
+		name          string
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}
+
+	list := NewListFromSlice([]int{10, 20, 30, 40})
+
+	testScenarios := []testScenario{
+		{name: "search before all values", target: 5, expectedIndex: 0, expectedFound: false},
+		{name: "search present value", target: 30, expectedIndex: 2, expectedFound: true},
+		{name: "search between values", target: 25, expectedIndex: 2, expectedFound: false},
+		{name: "search after all values", target: 50, expectedIndex: 4, expectedFound: false},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualIndex, actualFound := list.BinarySearchFunc(ts.target, func(a, b int) int { return a - b })
+
+			if actualIndex != ts.expectedIndex {
+				test.ReportTestFailure(t, actualIndex, ts.expectedIndex)
+			}
+
+			if actualFound != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+		})
+	}
+}
+
+func TestList_Reverse(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []int
+		expectedSlice []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "reverse empty list", startingSlice: []int{}, expectedSlice: []int{}},
+		{name: "reverse single value", startingSlice: []int{1}, expectedSlice: []int{1}},
+		{name: "reverse odd length", startingSlice: []int{1, 2, 3}, expectedSlice: []int{3, 2, 1}},
+		{name: "reverse even length", startingSlice: []int{1, 2, 3, 4}, expectedSlice: []int{4, 3, 2, 1}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+			list.Reverse()
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+}
+
+func TestList_Filter(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []int
+		expectedSlice []int
+	}
+
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	testScenarios := []testScenario{
+		{name: "filter empty list", startingSlice: []int{}, expectedSlice: []int{}},
+		{name: "filter keeps nothing", startingSlice: []int{1, 3}, expectedSlice: []int{}},
+		{name: "filter keeps some", startingSlice: []int{1, 2, 3, 4}, expectedSlice: []int{2, 4}},
+		{name: "filter keeps everything", startingSlice: []int{2, 4}, expectedSlice: []int{2, 4}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(slices.Clone(ts.startingSlice))
+			filtered := list.Filter(isEven)
+
+			if !cmp.Equal(filtered.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, filtered.coreSlice, ts.expectedSlice)
+			}
+
+			// filtering must leave the original untouched
+			if !cmp.Equal(list.coreSlice, ts.startingSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.startingSlice)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []int
+		expectedSlice []string
+	}
+
+	testScenarios := []testScenario{
+		{name: "map empty list", startingSlice: []int{}, expectedSlice: []string{}},
+		{name: "map to another type", startingSlice: []int{1, 22, 333}, expectedSlice: []string{"1", "22", "333"}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			mapped := Map(NewListFromSlice(ts.startingSlice), strconv.Itoa)
+
+			if !cmp.Equal(mapped.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, mapped.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	type testScenario struct {
+		name           string
+		startingSlice  []string
+		expectedResult int
+	}
+
+	totalLength := func(result int, value string) int { return result + len(value) }
+
+	testScenarios := []testScenario{
+		{name: "reduce empty list gives initial", startingSlice: []string{}, expectedResult: 100},
+		{name: "reduce into another type", startingSlice: []string{"a", "bb", "ccc"}, expectedResult: 106},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualResult := Reduce(NewListFromSlice(ts.startingSlice), 100, totalLength)
+
+			if actualResult != ts.expectedResult {
+				test.ReportTestFailure(t, actualResult, ts.expectedResult)
+			}
+		})
+	}
+
+	t.Run("reduce in order", func(t *testing.T) {
+		concatenated := Reduce(NewListFromSlice([]string{"a", "b", "c"}), "", func(result, value string) string {
+			return result + value
+		})
+
+		if concatenated != "abc" {
+			test.ReportTestFailure(t, concatenated, "abc")
+		}
+	})
+}
+
+func TestList_AnyMatchAllMatch(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []int
+		expectedAny   bool
+		expectedAll   bool
+	}
+
+	isPositive := func(value int) bool { return value > 0 }
+
+	testScenarios := []testScenario{
+		{name: "empty list", startingSlice: []int{}, expectedAny: false, expectedAll: true},
+		{name: "no values match", startingSlice: []int{-1, 0}, expectedAny: false, expectedAll: false},
+		{name: "some values match", startingSlice: []int{-1, 1}, expectedAny: true, expectedAll: false},
+		{name: "every value matches", startingSlice: []int{1, 2}, expectedAny: true, expectedAll: true},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(ts.startingSlice)
+
+			if actualAny := list.AnyMatch(isPositive); actualAny != ts.expectedAny {
+				test.ReportTestFailure(t, actualAny, ts.expectedAny)
+			}
+
+			if actualAll := list.AllMatch(isPositive); actualAll != ts.expectedAll {
+				test.ReportTestFailure(t, actualAll, ts.expectedAll)
+			}
+		})
+	}
+}
+
+func TestDedup(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingSlice []int
+		expectedSlice []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "dedup empty list", startingSlice: []int{}, expectedSlice: []int{}},
+		{name: "dedup unique values", startingSlice: []int{3, 1, 2}, expectedSlice: []int{3, 1, 2}},
+		{name: "dedup consecutive repeats", startingSlice: []int{1, 1, 2, 2, 2}, expectedSlice: []int{1, 2}},
+		{name: "dedup scattered repeats keeps first", startingSlice: []int{2, 1, 2, 3, 1}, expectedSlice: []int{2, 1, 3}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			list := NewListFromSlice(slices.Clone(ts.startingSlice))
+			Dedup(list)
+
+			if !cmp.Equal(list.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, list.coreSlice, ts.expectedSlice)
+			}
+
+			funcList := NewListFromSlice(slices.Clone(ts.startingSlice))
+			funcList.DedupFunc(func(a, b int) bool { return a == b })
+
+			if !cmp.Equal(funcList.coreSlice, ts.expectedSlice) {
+				test.ReportTestFailure(t, funcList.coreSlice, ts.expectedSlice)
+			}
+		})
+	}
+
+	t.Run("dedup values that are not comparable", func(t *testing.T) {
+		list := NewListFromSlice([]point{{[]int{1}}, {[]int{2}}, {[]int{1}}})
+		list.DedupFunc(equalPoints)
+
+		if list.Length() != 2 || !equalPoints(list.Get(1), point{[]int{2}}) {
+			test.ReportTestFailure(t, list.coreSlice, "two distinct points")
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package collection
+
+import "iter"
+
+// OrderedMap defines a map that remembers the order its keys were first set in. It is backed by a golang map of
+// entries that are also linked together in order, so lookups, updates, deletes and moves all run in O(1) while
+// iteration always visits the keys in a deterministic order.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedMapEntry[K, V]
+	root    orderedMapEntry[K, V] // sentinel entry; root.next is the first entry and root.prev is the last
+}
+
+// orderedMapEntry holds a key and value along with its neighbours in order.
+type orderedMapEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedMapEntry[K, V]
+	next  *orderedMapEntry[K, V]
+}
+
+// NewOrderedMap constructs a new ordered map with the given key type K and value type V.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	m.init()
+
+	return m
+}
+
+// init sets up the underlying map and the empty ring of entries.
+func (m *OrderedMap[K, V]) init() {
+	m.entries = make(map[K]*orderedMapEntry[K, V])
+	m.root.next = &m.root
+	m.root.prev = &m.root
+}
+
+// Empty removes all entries from the OrderedMap and reduces its size to 0.
+func (m *OrderedMap[K, V]) Empty() {
+	m.init()
+}
+
+// Set stores the value under the key. A new key is placed at the back of the order while an existing key keeps its
+// place and has its value replaced.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	// in the case that an empty struct was used, let's initialize the underlying map
+	if m.entries == nil {
+		m.init()
+	}
+
+	if entry, ok := m.entries[key]; ok {
+		entry.value = value
+		return
+	}
+
+	entry := &orderedMapEntry[K, V]{
+		key:   key,
+		value: value,
+	}
+	m.entries[key] = entry
+	m.insertBefore(entry, &m.root)
+}
+
+// Get returns the value stored under the key and whether the key was found.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if entry, ok := m.entries[key]; ok {
+		return entry.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether the key is in the OrderedMap.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Delete takes the key and its value out of the OrderedMap, reporting whether the key was found.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	delete(m.entries, key)
+	m.unlink(entry)
+
+	return true
+}
+
+// Length returns the number of entries in the OrderedMap.
+func (m *OrderedMap[K, V]) Length() int {
+	return len(m.entries)
+}
+
+// MoveToFront moves the key to the front of the order, reporting whether the key was found.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(entry)
+	m.insertBefore(entry, m.root.next)
+
+	return true
+}
+
+// MoveToBack moves the key to the back of the order, reporting whether the key was found.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(entry)
+	m.insertBefore(entry, &m.root)
+
+	return true
+}
+
+// First returns the key and value at the front of the order. The returned bool is false when the map is empty.
+func (m *OrderedMap[K, V]) First() (K, V, bool) {
+	if m.Length() == 0 {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+
+	return m.root.next.key, m.root.next.value, true
+}
+
+// Last returns the key and value at the back of the order. The returned bool is false when the map is empty.
+func (m *OrderedMap[K, V]) Last() (K, V, bool) {
+	if m.Length() == 0 {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+
+	return m.root.prev.key, m.root.prev.value, true
+}
+
+// Keys returns the keys of the OrderedMap in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Length())
+	m.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// Values returns the values of the OrderedMap in the order of their keys.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Length())
+	m.Range(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// Range calls fn for each key and value in order, stopping early if fn returns false. The map must not be changed
+// while ranging over it.
+func (m *OrderedMap[K, V]) Range(fn func(key K, value V) bool) {
+	if m.Length() == 0 {
+		return
+	}
+
+	for entry := m.root.next; entry != &m.root; entry = entry.next {
+		if !fn(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the keys and values of the OrderedMap in order. The map must not be changed while
+// iterating over it.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// Backward returns an iterator over the keys and values of the OrderedMap in reverse order. The map must not be
+// changed while iterating over it.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.Length() == 0 {
+			return
+		}
+
+		for entry := m.root.prev; entry != &m.root; entry = entry.prev {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// insertBefore links the entry into the order just before mark.
+func (m *OrderedMap[K, V]) insertBefore(entry, mark *orderedMapEntry[K, V]) {
+	entry.prev = mark.prev
+	entry.next = mark
+	mark.prev.next = entry
+	mark.prev = entry
+}
+
+// unlink takes the entry out of the order.
+func (m *OrderedMap[K, V]) unlink(entry *orderedMapEntry[K, V]) {
+	entry.prev.next = entry.next
+	entry.next.prev = entry.prev
+	entry.prev = nil
+	entry.next = nil
+}
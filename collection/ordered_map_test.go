@@ -0,0 +1,395 @@
+package collection
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+// newOrderedMapFromKeys constructs an ordered map where each key maps to its position in keys.
+func newOrderedMapFromKeys(keys ...string) *OrderedMap[string, int] {
+	m := NewOrderedMap[string, int]()
+	for i, key := range keys {
+		m.Set(key, i)
+	}
+
+	return m
+}
+
+func TestOrderedMap_Set(t *testing.T) {
+	type testScenario struct {
+		name           string
+		startingMap    *OrderedMap[string, int]
+		key            string
+		value          int
+		expectedKeys   []string
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:           "set on empty struct",
+			startingMap:    &OrderedMap[string, int]{}, // create empty struct without constructor
+			key:            "a",
+			value:          1,
+			expectedKeys:   []string{"a"},
+			expectedValues: []int{1},
+		},
+		{
+			name:           "set new key goes to the back",
+			startingMap:    newOrderedMapFromKeys("c", "a"),
+			key:            "b",
+			value:          2,
+			expectedKeys:   []string{"c", "a", "b"},
+			expectedValues: []int{0, 1, 2},
+		},
+		{
+			name:           "set existing key keeps its place",
+			startingMap:    newOrderedMapFromKeys("c", "a", "b"),
+			key:            "c",
+			value:          10,
+			expectedKeys:   []string{"c", "a", "b"},
+			expectedValues: []int{10, 1, 2},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			ts.startingMap.Set(ts.key, ts.value)
+
+			if actualKeys := ts.startingMap.Keys(); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+
+			if actualValues := ts.startingMap.Values(); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			if ts.startingMap.Length() != len(ts.expectedKeys) {
+				test.ReportTestFailure(t, ts.startingMap.Length(), len(ts.expectedKeys))
+			}
+		})
+	}
+}
+
+func TestOrderedMap_GetHas(t *testing.T) {
+	type testScenario struct {
+		name          string
+		startingMap   *OrderedMap[string, int]
+		key           string
+		expectedValue int
+		expectedFound bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "get from empty struct",
+			startingMap:   &OrderedMap[string, int]{},
+			key:           "a",
+			expectedValue: 0,
+			expectedFound: false,
+		},
+		{
+			name:          "get missing key",
+			startingMap:   newOrderedMapFromKeys("a", "b"),
+			key:           "z",
+			expectedValue: 0,
+			expectedFound: false,
+		},
+		{
+			name:          "get present key",
+			startingMap:   newOrderedMapFromKeys("a", "b"),
+			key:           "b",
+			expectedValue: 1,
+			expectedFound: true,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualValue, actualFound := ts.startingMap.Get(ts.key)
+
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if actualFound != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+
+			if actualHas := ts.startingMap.Has(ts.key); actualHas != ts.expectedFound {
+				test.ReportTestFailure(t, actualHas, ts.expectedFound)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Delete(t *testing.T) {
+	type testScenario struct {
+		name            string
+		startingMap     *OrderedMap[string, int]
+		key             string
+		expectedDeleted bool
+		expectedKeys    []string
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:            "delete from empty struct",
+			startingMap:     &OrderedMap[string, int]{},
+			key:             "a",
+			expectedDeleted: false,
+			expectedKeys:    []string{},
+		},
+		{
+			name:            "delete missing key",
+			startingMap:     newOrderedMapFromKeys("a", "b"),
+			key:             "z",
+			expectedDeleted: false,
+			expectedKeys:    []string{"a", "b"},
+		},
+		{
+			name:            "delete first key",
+			startingMap:     newOrderedMapFromKeys("a", "b", "c"),
+			key:             "a",
+			expectedDeleted: true,
+			expectedKeys:    []string{"b", "c"},
+		},
+		{
+			name:            "delete middle key",
+			startingMap:     newOrderedMapFromKeys("a", "b", "c"),
+			key:             "b",
+			expectedDeleted: true,
+			expectedKeys:    []string{"a", "c"},
+		},
+		{
+			name:            "delete last key",
+			startingMap:     newOrderedMapFromKeys("a", "b", "c"),
+			key:             "c",
+			expectedDeleted: true,
+			expectedKeys:    []string{"a", "b"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualDeleted := ts.startingMap.Delete(ts.key)
+
+			if actualDeleted != ts.expectedDeleted {
+				test.ReportTestFailure(t, actualDeleted, ts.expectedDeleted)
+			}
+
+			if actualKeys := ts.startingMap.Keys(); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+
+	t.Run("re-setting a deleted key puts it at the back", func(t *testing.T) {
+		m := newOrderedMapFromKeys("a", "b", "c")
+		m.Delete("a")
+		m.Set("a", 9)
+
+		expectedKeys := []string{"b", "c", "a"}
+		if actualKeys := m.Keys(); !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+}
+
+func TestOrderedMap_Move(t *testing.T) {
+	type testScenario struct {
+		name          string
+		key           string
+		toFront       bool
+		expectedMoved bool
+		expectedKeys  []string
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "move missing key to front",
+			key:           "z",
+			toFront:       true,
+			expectedMoved: false,
+			expectedKeys:  []string{"a", "b", "c"},
+		},
+		{
+			name:          "move last key to front",
+			key:           "c",
+			toFront:       true,
+			expectedMoved: true,
+			expectedKeys:  []string{"c", "a", "b"},
+		},
+		{
+			name:          "move first key to front",
+			key:           "a",
+			toFront:       true,
+			expectedMoved: true,
+			expectedKeys:  []string{"a", "b", "c"},
+		},
+		{
+			name:          "move missing key to back",
+			key:           "z",
+			expectedMoved: false,
+			expectedKeys:  []string{"a", "b", "c"},
+		},
+		{
+			name:          "move first key to back",
+			key:           "a",
+			expectedMoved: true,
+			expectedKeys:  []string{"b", "c", "a"},
+		},
+		{
+			name:          "move middle key to back",
+			key:           "b",
+			expectedMoved: true,
+			expectedKeys:  []string{"a", "c", "b"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			m := newOrderedMapFromKeys("a", "b", "c")
+
+			var actualMoved bool
+			if ts.toFront {
+				actualMoved = m.MoveToFront(ts.key)
+			} else {
+				actualMoved = m.MoveToBack(ts.key)
+			}
+
+			if actualMoved != ts.expectedMoved {
+				test.ReportTestFailure(t, actualMoved, ts.expectedMoved)
+			}
+
+			if actualKeys := m.Keys(); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_FirstLast(t *testing.T) {
+	type testScenario struct {
+		name               string
+		startingMap        *OrderedMap[string, int]
+		expectedFirstKey   string
+		expectedLastKey    string
+		expectedFirstValue int
+		expectedLastValue  int
+		expectedFound      bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "empty struct",
+			startingMap:   &OrderedMap[string, int]{},
+			expectedFound: false,
+		},
+		{
+			name:               "single entry",
+			startingMap:        newOrderedMapFromKeys("only"),
+			expectedFirstKey:   "only",
+			expectedLastKey:    "only",
+			expectedFirstValue: 0,
+			expectedLastValue:  0,
+			expectedFound:      true,
+		},
+		{
+			name:               "multiple entries",
+			startingMap:        newOrderedMapFromKeys("x", "y", "z"),
+			expectedFirstKey:   "x",
+			expectedLastKey:    "z",
+			expectedFirstValue: 0,
+			expectedLastValue:  2,
+			expectedFound:      true,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			firstKey, firstValue, firstFound := ts.startingMap.First()
+			if firstKey != ts.expectedFirstKey || firstValue != ts.expectedFirstValue || firstFound != ts.expectedFound {
+				test.ReportTestFailure(t, []any{firstKey, firstValue, firstFound},
+					[]any{ts.expectedFirstKey, ts.expectedFirstValue, ts.expectedFound})
+			}
+
+			lastKey, lastValue, lastFound := ts.startingMap.Last()
+			if lastKey != ts.expectedLastKey || lastValue != ts.expectedLastValue || lastFound != ts.expectedFound {
+				test.ReportTestFailure(t, []any{lastKey, lastValue, lastFound},
+					[]any{ts.expectedLastKey, ts.expectedLastValue, ts.expectedFound})
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Range(t *testing.T) {
+	m := newOrderedMapFromKeys("a", "b", "c", "d")
+
+	t.Run("range over every entry", func(t *testing.T) {
+		actualKeys := make([]string, 0)
+		m.Range(func(key string, _ int) bool {
+			actualKeys = append(actualKeys, key)
+			return true
+		})
+
+		expectedKeys := []string{"a", "b", "c", "d"}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+
+	t.Run("stop ranging early", func(t *testing.T) {
+		actualKeys := make([]string, 0)
+		m.Range(func(key string, value int) bool {
+			actualKeys = append(actualKeys, key)
+			return value < 1
+		})
+
+		expectedKeys := []string{"a", "b"}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+
+	t.Run("iterate in order and in reverse", func(t *testing.T) {
+		actualKeys := make([]string, 0)
+		for key, value := range m.All() {
+			if value != len(actualKeys) {
+				test.ReportTestFailure(t, value, len(actualKeys))
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys := []string{"a", "b", "c", "d"}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+
+		actualKeys = actualKeys[:0]
+		for key := range m.Backward() {
+			if key == "b" {
+				break
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys = []string{"d", "c"}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+
+	t.Run("empty clears every entry", func(t *testing.T) {
+		m.Empty()
+
+		if m.Length() != 0 {
+			test.ReportTestFailure(t, m.Length(), 0)
+		}
+
+		if actualKeys := m.Keys(); len(actualKeys) != 0 {
+			test.ReportTestFailure(t, actualKeys, []string{})
+		}
+	})
+}
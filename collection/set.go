@@ -0,0 +1,176 @@
+package collection
+
+import (
+	"iter"
+	"maps"
+)
+
+// ensure Set satisfies the Iterable interface at compile time
+var _ Iterable[any] = (*Set[any])(nil)
+
+// Set defines an unordered collection of unique data. It is backed by a golang map.
+type Set[T comparable] struct {
+	coreMap map[T]struct{}
+}
+
+// NewSet constructs a new set with the given type T.
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{
+		coreMap: make(map[T]struct{}),
+	}
+}
+
+// NewSetFromSlice constructs a new set from the values of a given slice with the given type T. Repeated values are
+// only kept once.
+func NewSetFromSlice[T comparable](slice []T) *Set[T] {
+	s := &Set[T]{
+		coreMap: make(map[T]struct{}, len(slice)),
+	}
+
+	for _, value := range slice {
+		s.Add(value)
+	}
+
+	return s
+}
+
+// NewSetFromList constructs a new set from the values of a given List with the given type T. Repeated values are
+// only kept once.
+func NewSetFromList[T comparable](list *List[T]) *Set[T] {
+	return NewSetFromSlice(list.coreSlice)
+}
+
+// Empty removes all elements from the Set and reduces its size to 0.
+func (s *Set[T]) Empty() {
+	s.coreMap = make(map[T]struct{})
+}
+
+// Add puts a new value into the Set. Adding a value the Set already contains does nothing.
+func (s *Set[T]) Add(value T) {
+	// in the case that an empty struct was used, let's initialize the underlying map
+	if s.coreMap == nil {
+		s.coreMap = make(map[T]struct{})
+	}
+
+	s.coreMap[value] = struct{}{}
+}
+
+// Delete takes the value out of the Set. Deleting a value the Set does not contain does nothing.
+func (s *Set[T]) Delete(value T) {
+	delete(s.coreMap, value)
+}
+
+// Contains reports whether the value is in the Set.
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.coreMap[value]
+	return ok
+}
+
+// Length returns the size of the Set.
+func (s *Set[T]) Length() int {
+	return len(s.coreMap)
+}
+
+// All returns an iterator over the values of the Set in no particular order. Values may be deleted from the Set while
+// iterating over it, but values added during iteration may or may not be visited.
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.coreMap)
+}
+
+// Union returns a new Set with the values that are in either Set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := &Set[T]{
+		coreMap: make(map[T]struct{}, len(s.coreMap)+len(other.coreMap)),
+	}
+
+	for value := range s.coreMap {
+		union.Add(value)
+	}
+	for value := range other.coreMap {
+		union.Add(value)
+	}
+
+	return union
+}
+
+// Intersection returns a new Set with the values that are in both Sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	// walk the smaller of the two sets
+	smaller, larger := s, other
+	if larger.Length() < smaller.Length() {
+		smaller, larger = larger, smaller
+	}
+
+	intersection := NewSet[T]()
+	for value := range smaller.coreMap {
+		if larger.Contains(value) {
+			intersection.Add(value)
+		}
+	}
+
+	return intersection
+}
+
+// Difference returns a new Set with the values that are in this Set but not in the other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	difference := NewSet[T]()
+	for value := range s.coreMap {
+		if !other.Contains(value) {
+			difference.Add(value)
+		}
+	}
+
+	return difference
+}
+
+// SymmetricDifference returns a new Set with the values that are in exactly one of the two Sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	difference := s.Difference(other)
+	for value := range other.coreMap {
+		if !s.Contains(value) {
+			difference.Add(value)
+		}
+	}
+
+	return difference
+}
+
+// IsSubset reports whether every value of this Set is also in the other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Length() > other.Length() {
+		return false
+	}
+
+	for value := range s.coreMap {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every value of the other Set is also in this one.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether both Sets hold exactly the same values.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Length() == other.Length() && s.IsSubset(other)
+}
+
+// ToSlice returns the values of the Set in a new slice. The order of the values is not defined.
+func (s *Set[T]) ToSlice() []T {
+	slice := make([]T, 0, len(s.coreMap))
+	for value := range s.coreMap {
+		slice = append(slice, value)
+	}
+
+	return slice
+}
+
+// ToList returns the values of the Set in a new List. The order of the values is not defined.
+func (s *Set[T]) ToList() *List[T] {
+	return NewListFromSlice(s.ToSlice())
+}
@@ -0,0 +1,358 @@
+package collection
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+// sortedSetValues returns the values of the set in ascending order so sets can be compared against slices.
+func sortedSetValues(s *Set[int]) []int {
+	values := s.ToSlice()
+	slices.Sort(values)
+
+	return values
+}
+
+func TestNewSetFromSlice(t *testing.T) {
+	type testScenario struct {
+		name           string
+		inputSlice     []int
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:           "construct from nil slice",
+			inputSlice:     nil,
+			expectedValues: []int{},
+		},
+		{
+			name:           "construct from slice of unique values",
+			inputSlice:     []int{3, 1, 2},
+			expectedValues: []int{1, 2, 3},
+		},
+		{
+			name:           "construct from slice with repeated values",
+			inputSlice:     []int{2, 2, 1, 2, 1},
+			expectedValues: []int{1, 2},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			set := NewSetFromSlice(ts.inputSlice)
+
+			actualValues := sortedSetValues(set)
+			if !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+}
+
+func TestNewSetFromList(t *testing.T) {
+	list := NewListFromSlice([]int{5, 4, 5, 4, 3})
+	set := NewSetFromList(list)
+
+	expectedValues := []int{3, 4, 5}
+	actualValues := sortedSetValues(set)
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+}
+
+func TestSet_AddDeleteContains(t *testing.T) {
+	type testScenario struct {
+		name             string
+		startingSet      *Set[int]
+		toAdd            []int
+		toDelete         []int
+		expectedValues   []int
+		expectedContains map[int]bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:             "add to empty struct",
+			startingSet:      &Set[int]{}, // create empty struct without constructor
+			toAdd:            []int{1},
+			expectedValues:   []int{1},
+			expectedContains: map[int]bool{1: true, 2: false},
+		},
+		{
+			name:             "add repeated values",
+			startingSet:      NewSet[int](),
+			toAdd:            []int{1, 1, 2},
+			expectedValues:   []int{1, 2},
+			expectedContains: map[int]bool{1: true, 2: true},
+		},
+		{
+			name:             "delete present and missing values",
+			startingSet:      NewSetFromSlice([]int{1, 2, 3}),
+			toDelete:         []int{2, 9},
+			expectedValues:   []int{1, 3},
+			expectedContains: map[int]bool{1: true, 2: false, 3: true, 9: false},
+		},
+		{
+			name:             "delete from empty struct",
+			startingSet:      &Set[int]{},
+			toDelete:         []int{1},
+			expectedValues:   []int{},
+			expectedContains: map[int]bool{1: false},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.toAdd {
+				ts.startingSet.Add(value)
+			}
+			for _, value := range ts.toDelete {
+				ts.startingSet.Delete(value)
+			}
+
+			actualValues := sortedSetValues(ts.startingSet)
+			if !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			if ts.startingSet.Length() != len(ts.expectedValues) {
+				test.ReportTestFailure(t, ts.startingSet.Length(), len(ts.expectedValues))
+			}
+
+			for value, expected := range ts.expectedContains {
+				if actual := ts.startingSet.Contains(value); actual != expected {
+					test.ReportTestFailure(t, actual, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSet_Empty(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3})
+	set.Empty()
+
+	if set.Length() != 0 {
+		test.ReportTestFailure(t, set.Length(), 0)
+	}
+
+	if set.Contains(1) {
+		test.ReportTestFailure(t, set.Contains(1), false)
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	type testScenario struct {
+		name                        string
+		first                       []int
+		second                      []int
+		expectedUnion               []int
+		expectedIntersection        []int
+		expectedDifference          []int
+		expectedSymmetricDifference []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:                        "both empty",
+			first:                       []int{},
+			second:                      []int{},
+			expectedUnion:               []int{},
+			expectedIntersection:        []int{},
+			expectedDifference:          []int{},
+			expectedSymmetricDifference: []int{},
+		},
+		{
+			name:                        "one empty",
+			first:                       []int{1, 2},
+			second:                      []int{},
+			expectedUnion:               []int{1, 2},
+			expectedIntersection:        []int{},
+			expectedDifference:          []int{1, 2},
+			expectedSymmetricDifference: []int{1, 2},
+		},
+		{
+			name:                        "disjoint",
+			first:                       []int{1, 2},
+			second:                      []int{3, 4},
+			expectedUnion:               []int{1, 2, 3, 4},
+			expectedIntersection:        []int{},
+			expectedDifference:          []int{1, 2},
+			expectedSymmetricDifference: []int{1, 2, 3, 4},
+		},
+		{
+			name:                        "overlapping",
+			first:                       []int{1, 2, 3, 4},
+			second:                      []int{3, 4, 5},
+			expectedUnion:               []int{1, 2, 3, 4, 5},
+			expectedIntersection:        []int{3, 4},
+			expectedDifference:          []int{1, 2},
+			expectedSymmetricDifference: []int{1, 2, 5},
+		},
+		{
+			name:                        "identical",
+			first:                       []int{1, 2},
+			second:                      []int{2, 1},
+			expectedUnion:               []int{1, 2},
+			expectedIntersection:        []int{1, 2},
+			expectedDifference:          []int{},
+			expectedSymmetricDifference: []int{},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			first, second := NewSetFromSlice(ts.first), NewSetFromSlice(ts.second)
+
+			actualUnion := sortedSetValues(first.Union(second))
+			if !cmp.Equal(actualUnion, ts.expectedUnion) {
+				test.ReportTestFailure(t, actualUnion, ts.expectedUnion)
+			}
+
+			actualIntersection := sortedSetValues(first.Intersection(second))
+			if !cmp.Equal(actualIntersection, ts.expectedIntersection) {
+				test.ReportTestFailure(t, actualIntersection, ts.expectedIntersection)
+			}
+
+			actualDifference := sortedSetValues(first.Difference(second))
+			if !cmp.Equal(actualDifference, ts.expectedDifference) {
+				test.ReportTestFailure(t, actualDifference, ts.expectedDifference)
+			}
+
+			actualSymmetricDifference := sortedSetValues(first.SymmetricDifference(second))
+			if !cmp.Equal(actualSymmetricDifference, ts.expectedSymmetricDifference) {
+				test.ReportTestFailure(t, actualSymmetricDifference, ts.expectedSymmetricDifference)
+			}
+
+			// set algebra must leave the operands untouched
+			if actualFirst := sortedSetValues(first); !cmp.Equal(actualFirst, sortedSetValues(NewSetFromSlice(ts.first))) {
+				test.ReportTestFailure(t, actualFirst, ts.first)
+			}
+		})
+	}
+}
+
+func TestSet_Relations(t *testing.T) {
+	type testScenario struct {
+		name             string
+		first            []int
+		second           []int
+		expectedSubset   bool
+		expectedSuperset bool
+		expectedEqual    bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:             "both empty",
+			first:            []int{},
+			second:           []int{},
+			expectedSubset:   true,
+			expectedSuperset: true,
+			expectedEqual:    true,
+		},
+		{
+			name:             "empty is a subset of anything",
+			first:            []int{},
+			second:           []int{1},
+			expectedSubset:   true,
+			expectedSuperset: false,
+			expectedEqual:    false,
+		},
+		{
+			name:             "proper subset",
+			first:            []int{1, 2},
+			second:           []int{1, 2, 3},
+			expectedSubset:   true,
+			expectedSuperset: false,
+			expectedEqual:    false,
+		},
+		{
+			name:             "proper superset",
+			first:            []int{1, 2, 3},
+			second:           []int{3, 1},
+			expectedSubset:   false,
+			expectedSuperset: true,
+			expectedEqual:    false,
+		},
+		{
+			name:             "equal",
+			first:            []int{1, 2, 3},
+			second:           []int{3, 2, 1},
+			expectedSubset:   true,
+			expectedSuperset: true,
+			expectedEqual:    true,
+		},
+		{
+			name:             "same size but different",
+			first:            []int{1, 2},
+			second:           []int{2, 3},
+			expectedSubset:   false,
+			expectedSuperset: false,
+			expectedEqual:    false,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			first, second := NewSetFromSlice(ts.first), NewSetFromSlice(ts.second)
+
+			if actual := first.IsSubset(second); actual != ts.expectedSubset {
+				test.ReportTestFailure(t, actual, ts.expectedSubset)
+			}
+
+			if actual := first.IsSuperset(second); actual != ts.expectedSuperset {
+				test.ReportTestFailure(t, actual, ts.expectedSuperset)
+			}
+
+			if actual := first.Equal(second); actual != ts.expectedEqual {
+				test.ReportTestFailure(t, actual, ts.expectedEqual)
+			}
+		})
+	}
+}
+
+func TestSet_ToList(t *testing.T) {
+	set := NewSetFromSlice([]string{"b", "a", "b"})
+	list := set.ToList()
+
+	actualValues := slices.Clone(list.coreSlice)
+	slices.Sort(actualValues)
+
+	expectedValues := []string{"a", "b"}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	if list.Length() != len(expectedValues) {
+		test.ReportTestFailure(t, list.Length(), len(expectedValues))
+	}
+}
+
+func TestSet_All(t *testing.T) {
+	s := NewSetFromSlice([]int{3, 1, 2, 3})
+
+	actualValues := make([]int, 0)
+	for value := range s.All() {
+		actualValues = append(actualValues, value)
+	}
+	slices.Sort(actualValues)
+
+	expectedValues := []int{1, 2, 3}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	t.Run("delete while iterating", func(t *testing.T) {
+		for value := range s.All() {
+			s.Delete(value)
+		}
+
+		if s.Length() != 0 {
+			test.ReportTestFailure(t, s.Length(), 0)
+		}
+	})
+}
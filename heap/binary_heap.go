@@ -0,0 +1,211 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// For ease of implementation, we will use a simple slice or array implementation for a tree.
+// This means that we follow these common rules for indices:
+// - *Parent Index*: (i - 1) / 2
+// - *Children Indices*
+//		- Left Child: 2 * i + 1
+// 		- Right Child: 2 * i + 2
+
+// ensure Heap satisfies the Heaper interface at compile time
+var _ Heaper[int] = (*Heap[int])(nil)
+
+// Heap represents a binary heap ordered by a less function. The value for which less reports true against every
+// other value sits at the top of the heap and is the next to be popped. A less of a < b gives a min heap while a less
+// of a > b gives a max heap; any other ordering, such as by deadline and then by ID, works just the same.
+type Heap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// New constructs an empty heap ordered by the given less function.
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{
+		data: []T{},
+		less: less,
+	}
+}
+
+// NewMin constructs an empty heap with the smallest values towards the top.
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(cmp.Less[T])
+}
+
+// NewMax constructs an empty heap with the largest values towards the top.
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(greater[T])
+}
+
+// NewFromSlice constructs a heap ordered by the given less function out of the values of a slice. The heap takes
+// ownership of the slice and arranges it in place in O(n), which is quicker than adding the values one at a time.
+func NewFromSlice[T any](values []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{
+		data: values,
+		less: less,
+	}
+	if h.data == nil {
+		h.data = []T{}
+	}
+
+	h.heapify()
+
+	return h
+}
+
+// NewMinFromSlice constructs a heap with the smallest values towards the top out of the values of a slice.
+func NewMinFromSlice[T cmp.Ordered](values []T) *Heap[T] {
+	return NewFromSlice(values, cmp.Less[T])
+}
+
+// NewMaxFromSlice constructs a heap with the largest values towards the top out of the values of a slice.
+func NewMaxFromSlice[T cmp.Ordered](values []T) *Heap[T] {
+	return NewFromSlice(values, greater[T])
+}
+
+// Heapify arranges the given slice in place so that it satisfies the heap property for the given less function, with
+// the value that sorts first at index 0. This runs in O(n).
+func Heapify[T any](values []T, less func(a, b T) bool) {
+	h := Heap[T]{
+		data: values,
+		less: less,
+	}
+
+	h.heapify()
+}
+
+// Add inserts a new value into the Heap. After adding, the Heap fixes the remaining values ordering.
+func (h *Heap[T]) Add(value T) {
+	h.data = append(h.data, value)
+	h.bubbleUp(len(h.data) - 1) // bubble up the new value
+}
+
+// AddAll inserts every given value into the Heap. When the values outnumber those already in the heap, the heap is
+// rebuilt from the bottom up in O(n + k) rather than adding each value in O(log n).
+func (h *Heap[T]) AddAll(values ...T) {
+	if len(values) < len(h.data) {
+		for _, value := range values {
+			h.Add(value)
+		}
+		return
+	}
+
+	h.data = append(h.data, values...)
+	h.heapify()
+}
+
+// Pop removes the top value from the Heap. After removing, the Heap fixes the remaining values ordering.
+func (h *Heap[T]) Pop() (T, error) {
+	var zero T
+
+	if len(h.data) <= 0 {
+		return zero, fmt.Errorf("heap: pop called on empty heap")
+	}
+
+	removed := h.data[0]
+	last := len(h.data) - 1
+
+	h.data[0] = h.data[last]
+	h.data[last] = zero // clear the slot so the value can be garbage collected
+	h.data = h.data[:last]
+	h.bubbleDown(0)
+
+	return removed, nil
+}
+
+// GetFirstValue returns the top value of the heap. This does not remove the value from the heap.
+// Similar to a peek in a queue.
+func (h *Heap[T]) GetFirstValue() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("heap: get first value called on empty heap")
+	}
+
+	return h.data[0], nil
+}
+
+// Length returns the number of values in the Heap.
+func (h *Heap[T]) Length() int {
+	return len(h.data)
+}
+
+// All returns an iterator over the values of the Heap in heap order, which starts with the top value but is
+// otherwise unsorted. The heap must not be changed while iterating over it.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range h.data {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// heapify restores the heap property over the whole slice by bubbling down every parent, starting from the last one.
+func (h *Heap[T]) heapify() {
+	for index := getParentIndex(len(h.data) - 1); index >= 0; index-- {
+		h.bubbleDown(index)
+	}
+}
+
+func (h *Heap[T]) bubbleUp(index int) {
+	for index > 0 {
+		parentIndex := getParentIndex(index)
+
+		if !h.less(h.data[index], h.data[parentIndex]) {
+			// the value is now in the correct place and is bubbled up; we are done
+			return
+		}
+
+		h.data[parentIndex], h.data[index] = h.data[index], h.data[parentIndex] //swap the values
+		index = parentIndex
+	}
+}
+
+func (h *Heap[T]) bubbleDown(index int) {
+	for getLeftIndex(index) < len(h.data) {
+		firstChildIndex := h.firstChildIndex(index)
+
+		if !h.less(h.data[firstChildIndex], h.data[index]) {
+			// the value is now in the correct place and is bubbled down; we are done
+			return
+		}
+
+		h.data[firstChildIndex], h.data[index] = h.data[index], h.data[firstChildIndex]
+		index = firstChildIndex
+	}
+}
+
+// firstChildIndex returns the index of the child that should sit closest to the top.
+func (h *Heap[T]) firstChildIndex(index int) int {
+	if getRightIndex(index) >= len(h.data) {
+		return getLeftIndex(index)
+	}
+
+	if h.less(h.data[getRightIndex(index)], h.data[getLeftIndex(index)]) {
+		return getRightIndex(index)
+	}
+
+	return getLeftIndex(index)
+}
+
+func greater[T cmp.Ordered](a, b T) bool {
+	return cmp.Less(b, a)
+}
+
+func getParentIndex(index int) int {
+	return (index - 1) / 2
+}
+
+func getLeftIndex(index int) int {
+	return 2*index + 1
+}
+
+func getRightIndex(index int) int {
+	return 2*index + 2
+}
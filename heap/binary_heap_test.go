@@ -0,0 +1,534 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// testNode is a simple keyed value used to check the layout of heaps ordered by a custom less function.
+type testNode struct {
+	Key   int
+	Value any
+}
+
+func newTestNode(key int, value any) testNode {
+	return testNode{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// newTestMaxHeap constructs a heap with the max keyed nodes at the top.
+func newTestMaxHeap() *Heap[testNode] {
+	return New(func(a, b testNode) bool {
+		return a.Key > b.Key
+	})
+}
+
+// drainHeap pops every value off the heap in order.
+func drainHeap[T any](h interface {
+	Pop() (T, error)
+	Length() int
+}) []T {
+	values := make([]T, 0, h.Length())
+	for h.Length() > 0 {
+		value, _ := h.Pop()
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func TestBinaryHeap_Add(t *testing.T) {
+	type scenario struct {
+		name         string
+		startingHeap *Heap[testNode]
+		input        testNode
+		expected     []testNode
+	}
+
+	maxHeapWithLow := newTestMaxHeap()
+	maxHeapWithLow.Add(newTestNode(0, "testing!"))
+
+	maxHeapWithHigh := newTestMaxHeap()
+	maxHeapWithHigh.Add(newTestNode(99, "testing it all!"))
+
+	maxHeapWithSpread := newTestMaxHeap()
+	maxHeapWithSpread.Add(newTestNode(0, "yee"))
+	maxHeapWithSpread.Add(newTestNode(99, "haw"))
+
+	testScenarios := []scenario{
+		{
+			name:         "add a node to an empty heap",
+			startingHeap: newTestMaxHeap(),
+			input:        newTestNode(1, "hello!"),
+			expected:     []testNode{newTestNode(1, "hello!")},
+		},
+		{
+			name:         "add a node to a heap with Key lower than rest",
+			startingHeap: maxHeapWithHigh,
+			input:        newTestNode(0, "konichiwa!"),
+			expected:     []testNode{newTestNode(99, "testing it all!"), newTestNode(0, "konichiwa!")},
+		},
+		{
+			name:         "add a node to a heap with Key higher than rest",
+			startingHeap: maxHeapWithLow,
+			input:        newTestNode(999999, "woah"),
+			expected:     []testNode{newTestNode(999999, "woah"), newTestNode(0, "testing!")},
+		},
+		{
+			name:         "add a node to a heap with a Key in between the rest",
+			startingHeap: maxHeapWithSpread,
+			input:        newTestNode(50, "middle"),
+			expected:     []testNode{newTestNode(99, "haw"), newTestNode(0, "yee"), newTestNode(50, "middle")},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			// add the new input node
+			ts.startingHeap.Add(ts.input)
+
+			actualHeap := ts.startingHeap.data
+			if !cmp.Equal(actualHeap, ts.expected) {
+				test.ReportTestFailure(t, actualHeap, ts.expected)
+			}
+		})
+	}
+}
+
+func TestBinaryHeap_Pop(t *testing.T) {
+	type scenario struct {
+		name                  string
+		startingHeap          *Heap[testNode]
+		expectedValue         testNode
+		expectedErr           error
+		expectedRemainingHeap []testNode
+	}
+
+	maxHeapWithLow := newTestMaxHeap()
+	maxHeapWithLow.Add(newTestNode(0, "testing!"))
+
+	maxHeapWithSpread := newTestMaxHeap()
+	maxHeapWithSpread.Add(newTestNode(0, "yee"))
+	maxHeapWithSpread.Add(newTestNode(99, "haw"))
+
+	testScenarios := []scenario{
+		{
+			name:                  "pop on an empty heap",
+			startingHeap:          newTestMaxHeap(),
+			expectedErr:           fmt.Errorf("heap: pop called on empty heap"),
+			expectedRemainingHeap: []testNode{},
+		},
+		{
+			name:                  "pop on a single node queue",
+			startingHeap:          maxHeapWithLow,
+			expectedValue:         newTestNode(0, "testing!"),
+			expectedRemainingHeap: []testNode{},
+		},
+		{
+			name:                  "pop with multiple possible nodes",
+			startingHeap:          maxHeapWithSpread,
+			expectedValue:         newTestNode(99, "haw"),
+			expectedRemainingHeap: []testNode{newTestNode(0, "yee")},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualValue, actualErr := ts.startingHeap.Pop()
+
+			if !test.IsErrSame(actualErr, ts.expectedErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedErr)
+			}
+
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			actualHeap := ts.startingHeap.data
+			if !cmp.Equal(actualHeap, ts.expectedRemainingHeap) {
+				test.ReportTestFailure(t, actualHeap, ts.expectedRemainingHeap)
+			}
+		})
+	}
+}
+
+func TestBinaryHeap_GetFirstValue(t *testing.T) {
+	type scenario struct {
+		name          string
+		heap          *Heap[testNode]
+		expectedValue testNode
+		expectedErr   error
+		expectedHeap  []testNode
+	}
+
+	maxHeapWithHigh := newTestMaxHeap()
+	maxHeapWithHigh.Add(newTestNode(99, "testing it all!"))
+
+	maxHeapWithSpread := newTestMaxHeap()
+	maxHeapWithSpread.Add(newTestNode(0, "yee"))
+	maxHeapWithSpread.Add(newTestNode(99, "haw"))
+
+	maxHeapWithFiveNodes := newTestMaxHeap()
+	maxHeapWithFiveNodes.Add(newTestNode(0, 0))
+	maxHeapWithFiveNodes.Add(newTestNode(50, 50))
+	maxHeapWithFiveNodes.Add(newTestNode(200, 200))
+	maxHeapWithFiveNodes.Add(newTestNode(100, 100))
+	maxHeapWithFiveNodes.Add(newTestNode(999999, 999999))
+
+	testScenarios := []scenario{
+		{
+			name:         "try to get first value from empty heap",
+			heap:         newTestMaxHeap(),
+			expectedErr:  fmt.Errorf("heap: get first value called on empty heap"),
+			expectedHeap: []testNode{},
+		},
+		{
+			name:          "get from only single node heap",
+			heap:          maxHeapWithHigh,
+			expectedValue: newTestNode(99, "testing it all!"),
+			expectedHeap:  []testNode{newTestNode(99, "testing it all!")},
+		},
+		{
+			name:          "get max value from heap with 2 nodes",
+			heap:          maxHeapWithSpread,
+			expectedValue: newTestNode(99, "haw"),
+			expectedHeap:  []testNode{newTestNode(99, "haw"), newTestNode(0, "yee")},
+		},
+		{
+			name:          "get max value from heap with multiple nodes",
+			heap:          maxHeapWithFiveNodes,
+			expectedValue: newTestNode(999999, 999999),
+			expectedHeap: []testNode{
+				newTestNode(999999, 999999), newTestNode(200, 200), newTestNode(50, 50), newTestNode(0, 0),
+				newTestNode(100, 100),
+			},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualValue, actualErr := ts.heap.GetFirstValue()
+
+			if !test.IsErrSame(actualErr, ts.expectedErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedErr)
+			}
+
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			actualHeap := ts.heap.data
+			if !cmp.Equal(actualHeap, ts.expectedHeap) {
+				test.ReportTestFailure(t, actualHeap, ts.expectedHeap)
+			}
+		})
+	}
+}
+
+func TestBinaryHeap_Ordering(t *testing.T) {
+	type task struct {
+		Deadline int
+		ID       string
+	}
+
+	type scenario struct {
+		name          string
+		heap          Heaper[int]
+		input         []int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "min heap pops smallest first",
+			heap:          NewMin[int](),
+			input:         []int{5, 3, 9, 1, 7, 3},
+			expectedOrder: []int{1, 3, 3, 5, 7, 9},
+		},
+		{
+			name:          "max heap pops largest first",
+			heap:          NewMax[int](),
+			input:         []int{5, 3, 9, 1, 7, 3},
+			expectedOrder: []int{9, 7, 5, 3, 3, 1},
+		},
+		{
+			name:          "empty heap drains to nothing",
+			heap:          NewMin[int](),
+			input:         []int{},
+			expectedOrder: []int{},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.input {
+				ts.heap.Add(value)
+			}
+
+			actualOrder := drainHeap(ts.heap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+
+	t.Run("custom ordering by deadline then by ID", func(t *testing.T) {
+		tasks := New(func(a, b task) bool {
+			if a.Deadline != b.Deadline {
+				return a.Deadline < b.Deadline
+			}
+			return a.ID < b.ID
+		})
+
+		tasks.Add(task{Deadline: 2, ID: "b"})
+		tasks.Add(task{Deadline: 1, ID: "z"})
+		tasks.Add(task{Deadline: 2, ID: "a"})
+		tasks.Add(task{Deadline: 3, ID: "a"})
+
+		expectedOrder := []task{{1, "z"}, {2, "a"}, {2, "b"}, {3, "a"}}
+
+		actualOrder := drainHeap[task](tasks)
+		if !cmp.Equal(actualOrder, expectedOrder) {
+			test.ReportTestFailure(t, actualOrder, expectedOrder)
+		}
+	})
+}
+
+// checkHeap verifies that no value sorts before its parent.
+func checkHeap[T any](t *testing.T, h *Heap[T]) {
+	t.Helper()
+
+	for i := 1; i < len(h.data); i++ {
+		if h.less(h.data[i], h.data[getParentIndex(i)]) {
+			t.Errorf("scenario: %s \n\t heap property violated at index %d", t.Name(), i)
+		}
+	}
+}
+
+func TestBinaryHeap_NewFromSlice(t *testing.T) {
+	type scenario struct {
+		name          string
+		heap          *Heap[int]
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "from nil slice",
+			heap:          NewMinFromSlice[int](nil),
+			expectedOrder: []int{},
+		},
+		{
+			name:          "from single value",
+			heap:          NewMaxFromSlice([]int{7}),
+			expectedOrder: []int{7},
+		},
+		{
+			name:          "min heap from unordered slice",
+			heap:          NewMinFromSlice([]int{9, 4, 7, 1, 8, 2, 2, 6}),
+			expectedOrder: []int{1, 2, 2, 4, 6, 7, 8, 9},
+		},
+		{
+			name:          "max heap from unordered slice",
+			heap:          NewMaxFromSlice([]int{9, 4, 7, 1, 8, 2, 2, 6}),
+			expectedOrder: []int{9, 8, 7, 6, 4, 2, 2, 1},
+		},
+		{
+			name: "custom ordering from slice",
+			heap: NewFromSlice([]int{15, 4, 23, 8, 42, 16}, func(a, b int) bool {
+				return a%10 < b%10 // order by last digit
+			}),
+			expectedOrder: []int{42, 23, 4, 15, 16, 8},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			checkHeap(t, ts.heap)
+
+			actualOrder := drainHeap[int](ts.heap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestHeapify(t *testing.T) {
+	values := []int{5, 9, 1, 3, 3, 8, 0, 7}
+	Heapify(values, greater[int])
+
+	if values[0] != 9 {
+		test.ReportTestFailure(t, values[0], 9)
+	}
+
+	checkHeap(t, &Heap[int]{data: values, less: greater[int]})
+}
+
+func TestBinaryHeap_AddAll(t *testing.T) {
+	type scenario struct {
+		name          string
+		startingHeap  *Heap[int]
+		input         []int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "add nothing",
+			startingHeap:  NewMinFromSlice([]int{3, 1}),
+			input:         []int{},
+			expectedOrder: []int{1, 3},
+		},
+		{
+			name:          "add all to empty heap",
+			startingHeap:  NewMin[int](),
+			input:         []int{4, 2, 8, 6},
+			expectedOrder: []int{2, 4, 6, 8},
+		},
+		{
+			name:          "add fewer values than the heap holds",
+			startingHeap:  NewMinFromSlice([]int{10, 30, 50, 70}),
+			input:         []int{60, 0},
+			expectedOrder: []int{0, 10, 30, 50, 60, 70},
+		},
+		{
+			name:          "add more values than the heap holds",
+			startingHeap:  NewMinFromSlice([]int{10, 30}),
+			input:         []int{60, 0, 20, 40},
+			expectedOrder: []int{0, 10, 20, 30, 40, 60},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			ts.startingHeap.AddAll(ts.input...)
+			checkHeap(t, ts.startingHeap)
+
+			actualOrder := drainHeap[int](ts.startingHeap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestHeaper_All(t *testing.T) {
+	type scenario struct {
+		name    string
+		newHeap func() Heaper[int]
+	}
+
+	testScenarios := []scenario{
+		{name: "binary heap", newHeap: func() Heaper[int] { return NewMin[int]() }},
+		{name: "d-ary heap", newHeap: func() Heaper[int] { return NewDaryMin[int](4) }},
+		{name: "pairing heap", newHeap: func() Heaper[int] { return NewPairingMin[int]() }},
+		{name: "leftist heap", newHeap: func() Heaper[int] { return NewLeftistMin[int]() }},
+		{name: "min-max heap", newHeap: func() Heaper[int] { return NewOrderedMinMax[int]() }},
+	}
+
+	values := rand.New(rand.NewSource(1)).Perm(100)
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := ts.newHeap()
+			for _, value := range values {
+				h.Add(value)
+			}
+
+			visited := make([]int, 0, len(values))
+			for value := range h.All() {
+				visited = append(visited, value)
+			}
+
+			// iterating visits every value once, starting with the top, and leaves the heap untouched
+			if len(visited) == 0 || visited[0] != 0 {
+				test.ReportTestFailure(t, visited, "the top value first")
+			}
+
+			slices.Sort(visited)
+			if expected := slices.Sorted(slices.Values(values)); !cmp.Equal(visited, expected) {
+				test.ReportTestFailure(t, visited, expected)
+			}
+
+			if h.Length() != len(values) {
+				test.ReportTestFailure(t, h.Length(), len(values))
+			}
+
+			// breaking out of the loop stops the iterator
+			count := 0
+			for range h.All() {
+				count++
+				if count == 3 {
+					break
+				}
+			}
+			if count != 3 {
+				test.ReportTestFailure(t, count, 3)
+			}
+		})
+	}
+
+	t.Run("indexed heap", func(t *testing.T) {
+		h := NewIndexedMin[int]()
+		for _, value := range values {
+			h.Add(value)
+		}
+
+		visited := slices.Sorted(h.All())
+		if expected := slices.Sorted(slices.Values(values)); !cmp.Equal(visited, expected) {
+			test.ReportTestFailure(t, visited, expected)
+		}
+	})
+}
+
+// benchmarkValues returns n pseudo-random values that are the same on every run.
+func benchmarkValues(n int) []int {
+	random := rand.New(rand.NewSource(1))
+
+	values := make([]int, n)
+	for i := range values {
+		values[i] = random.Int()
+	}
+
+	return values
+}
+
+func BenchmarkBinaryHeap_RepeatedAdd(b *testing.B) {
+	values := benchmarkValues(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := NewMin[int]()
+		for _, value := range values {
+			h.Add(value)
+		}
+	}
+}
+
+func BenchmarkBinaryHeap_NewFromSlice(b *testing.B) {
+	values := benchmarkValues(200_000)
+	scratch := make([]int, len(values))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(scratch, values)
+		NewMinFromSlice(scratch)
+	}
+}
+
+func BenchmarkBinaryHeap_AddAll(b *testing.B) {
+	values := benchmarkValues(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := NewMin[int]()
+		h.AddAll(values...)
+	}
+}
@@ -0,0 +1,151 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// The d-ary heap generalises the index rules of the binary heap to d children per node:
+// - *Parent Index*: (i - 1) / d
+// - *Children Indices*: d * i + 1 through d * i + d
+
+// ensure DaryHeap satisfies the Heaper interface at compile time
+var _ Heaper[int] = (*DaryHeap[int])(nil)
+
+// DaryHeap represents a heap ordered by a less function where every node has up to arity children. A wider heap is
+// shallower, so adds touch fewer levels and the children of a node sit next to each other in memory, which tends to
+// suit large queues better than a binary heap. Pops compare more children per level in exchange.
+type DaryHeap[T any] struct {
+	data  []T
+	arity int
+	less  func(a, b T) bool
+}
+
+// NewDary constructs an empty heap with the given arity ordered by the given less function. An arity below 2 panics.
+func NewDary[T any](arity int, less func(a, b T) bool) *DaryHeap[T] {
+	if arity < 2 {
+		panic("dary heap: arity must be at least 2")
+	}
+
+	return &DaryHeap[T]{
+		data:  []T{},
+		arity: arity,
+		less:  less,
+	}
+}
+
+// NewDaryMin constructs an empty heap with the given arity with the smallest values towards the top.
+func NewDaryMin[T cmp.Ordered](arity int) *DaryHeap[T] {
+	return NewDary(arity, cmp.Less[T])
+}
+
+// NewDaryMax constructs an empty heap with the given arity with the largest values towards the top.
+func NewDaryMax[T cmp.Ordered](arity int) *DaryHeap[T] {
+	return NewDary(arity, greater[T])
+}
+
+// Add inserts a new value into the DaryHeap. After adding, the DaryHeap fixes the remaining values ordering.
+func (h *DaryHeap[T]) Add(value T) {
+	h.data = append(h.data, value)
+	h.bubbleUp(len(h.data) - 1)
+}
+
+// Pop removes the top value from the DaryHeap. After removing, the DaryHeap fixes the remaining values ordering.
+func (h *DaryHeap[T]) Pop() (T, error) {
+	var zero T
+
+	if len(h.data) <= 0 {
+		return zero, fmt.Errorf("dary heap: pop called on empty heap")
+	}
+
+	removed := h.data[0]
+	last := len(h.data) - 1
+
+	h.data[0] = h.data[last]
+	h.data[last] = zero // clear the slot so the value can be garbage collected
+	h.data = h.data[:last]
+	h.bubbleDown(0)
+
+	return removed, nil
+}
+
+// GetFirstValue returns the top value of the heap. This does not remove the value from the heap.
+// Similar to a peek in a queue.
+func (h *DaryHeap[T]) GetFirstValue() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("dary heap: get first value called on empty heap")
+	}
+
+	return h.data[0], nil
+}
+
+// Length returns the number of values in the DaryHeap.
+func (h *DaryHeap[T]) Length() int {
+	return len(h.data)
+}
+
+// All returns an iterator over the values of the DaryHeap in heap order, which starts with the top value but is
+// otherwise unsorted. The heap must not be changed while iterating over it.
+func (h *DaryHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range h.data {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Arity returns the number of children each node of the DaryHeap may have.
+func (h *DaryHeap[T]) Arity() int {
+	return h.arity
+}
+
+func (h *DaryHeap[T]) bubbleUp(index int) {
+	for index > 0 {
+		parentIndex := (index - 1) / h.arity
+
+		if !h.less(h.data[index], h.data[parentIndex]) {
+			// the value is now in the correct place and is bubbled up; we are done
+			return
+		}
+
+		h.data[parentIndex], h.data[index] = h.data[index], h.data[parentIndex]
+		index = parentIndex
+	}
+}
+
+func (h *DaryHeap[T]) bubbleDown(index int) {
+	for {
+		firstChildIndex := h.firstChildIndex(index)
+		if firstChildIndex < 0 || !h.less(h.data[firstChildIndex], h.data[index]) {
+			// the value is now in the correct place and is bubbled down; we are done
+			return
+		}
+
+		h.data[firstChildIndex], h.data[index] = h.data[index], h.data[firstChildIndex]
+		index = firstChildIndex
+	}
+}
+
+// firstChildIndex returns the index of the child that should sit closest to the top, or -1 when there are no
+// children.
+func (h *DaryHeap[T]) firstChildIndex(index int) int {
+	start := h.arity*index + 1
+	if start >= len(h.data) {
+		return -1
+	}
+
+	end := min(start+h.arity, len(h.data))
+
+	first := start
+	for child := start + 1; child < end; child++ {
+		if h.less(h.data[child], h.data[first]) {
+			first = child
+		}
+	}
+
+	return first
+}
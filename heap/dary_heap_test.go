@@ -0,0 +1,158 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+// checkDaryHeap verifies that no value sorts before its parent.
+func checkDaryHeap[T any](t *testing.T, h *DaryHeap[T]) {
+	t.Helper()
+
+	for i := 1; i < len(h.data); i++ {
+		if h.less(h.data[i], h.data[(i-1)/h.arity]) {
+			t.Errorf("scenario: %s \n\t heap property violated at index %d", t.Name(), i)
+		}
+	}
+}
+
+func TestNewDaryPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected NewDary to panic with arity below 2")
+		}
+	}()
+
+	NewDaryMin[int](1)
+}
+
+func TestDaryHeap_AddPop(t *testing.T) {
+	type scenario struct {
+		name          string
+		heap          *DaryHeap[int]
+		input         []int
+		expectedFirst int
+		expectedErr   error
+		expectedOrder []int
+	}
+
+	input := []int{13, 2, 8, 21, 5, 1, 34, 3, 1, 55, 0, 89}
+
+	testScenarios := []scenario{
+		{
+			name:          "empty heap",
+			heap:          NewDaryMin[int](4),
+			input:         []int{},
+			expectedErr:   fmt.Errorf("dary heap: get first value called on empty heap"),
+			expectedOrder: []int{},
+		},
+		{
+			name:          "binary min heap",
+			heap:          NewDaryMin[int](2),
+			input:         input,
+			expectedFirst: 0,
+			expectedOrder: []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+		},
+		{
+			name:          "4-ary min heap",
+			heap:          NewDaryMin[int](4),
+			input:         input,
+			expectedFirst: 0,
+			expectedOrder: []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+		},
+		{
+			name:          "8-ary max heap",
+			heap:          NewDaryMax[int](8),
+			input:         input,
+			expectedFirst: 89,
+			expectedOrder: []int{89, 55, 34, 21, 13, 8, 5, 3, 2, 1, 1, 0},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.input {
+				ts.heap.Add(value)
+				checkDaryHeap(t, ts.heap)
+			}
+
+			actualFirst, actualErr := ts.heap.GetFirstValue()
+			if !test.IsErrSame(actualErr, ts.expectedErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedErr)
+			}
+
+			if actualFirst != ts.expectedFirst {
+				test.ReportTestFailure(t, actualFirst, ts.expectedFirst)
+			}
+
+			actualOrder := drainHeap[int](ts.heap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+
+			_, actualErr = ts.heap.Pop()
+			expectedErr := fmt.Errorf("dary heap: pop called on empty heap")
+			if !test.IsErrSame(actualErr, expectedErr) {
+				test.ReportTestFailure(t, actualErr, expectedErr)
+			}
+		})
+	}
+}
+
+// benchmarkPushHeavy adds every value and pops only a tenth of them.
+func benchmarkPushHeavy(b *testing.B, newHeap func() Heaper[int]) {
+	values := benchmarkValues(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := newHeap()
+		for j, value := range values {
+			h.Add(value)
+			if j%10 == 0 {
+				_, _ = h.Pop()
+			}
+		}
+	}
+}
+
+// benchmarkPopHeavy adds every value and then pops them all.
+func benchmarkPopHeavy(b *testing.B, newHeap func() Heaper[int]) {
+	values := benchmarkValues(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := newHeap()
+		for _, value := range values {
+			h.Add(value)
+		}
+		for h.Length() > 0 {
+			_, _ = h.Pop()
+		}
+	}
+}
+
+func BenchmarkDaryHeap_PushHeavy(b *testing.B) {
+	b.Run("binary heap", func(b *testing.B) {
+		benchmarkPushHeavy(b, func() Heaper[int] { return NewMin[int]() })
+	})
+
+	for _, arity := range []int{4, 8} {
+		b.Run(fmt.Sprintf("%d-ary heap", arity), func(b *testing.B) {
+			benchmarkPushHeavy(b, func() Heaper[int] { return NewDaryMin[int](arity) })
+		})
+	}
+}
+
+func BenchmarkDaryHeap_PopHeavy(b *testing.B) {
+	b.Run("binary heap", func(b *testing.B) {
+		benchmarkPopHeavy(b, func() Heaper[int] { return NewMin[int]() })
+	})
+
+	for _, arity := range []int{4, 8} {
+		b.Run(fmt.Sprintf("%d-ary heap", arity), func(b *testing.B) {
+			benchmarkPopHeavy(b, func() Heaper[int] { return NewDaryMin[int](arity) })
+		})
+	}
+}
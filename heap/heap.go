@@ -1,20 +1,12 @@
 package heap
 
-type heap interface {
-	Add(node Node)
-	Pop() (any, error)
-	GetFirstValue() (any, error)
-}
-
-type Node struct {
-	Key   int
-	Value any
-}
+import "iter"
 
-// NewNode creates a simple node structure for use in a heap.
-func NewNode(key int, value any) Node {
-	return Node{
-		Key:   key,
-		Value: value,
-	}
+// Heaper defines the needed methods to implement a heap.
+type Heaper[T any] interface {
+	Add(value T)
+	Pop() (T, error)
+	GetFirstValue() (T, error)
+	Length() int
+	All() iter.Seq[T]
 }
@@ -0,0 +1,196 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// Handle refers to a value held in an IndexedHeap. A handle stays valid for as long as its value is in the heap, no
+// matter how the heap reorders itself, which allows the value to be updated or removed later on.
+type Handle[T any] struct {
+	value T
+	index int // position of the handle in the heap; -1 once it has left the heap
+	heap  *IndexedHeap[T]
+}
+
+// Value returns the value the handle refers to.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// IndexedHeap is a binary heap ordered by a less function that tracks the position of every value it holds. Adding a
+// value returns a Handle which can be used to update or remove that value in O(log n), making the heap suitable for
+// algorithms such as Dijkstra's and A* which need to decrease the key of a queued value.
+type IndexedHeap[T any] struct {
+	data []*Handle[T]
+	less func(a, b T) bool
+}
+
+// NewIndexed constructs an empty indexed heap ordered by the given less function.
+func NewIndexed[T any](less func(a, b T) bool) *IndexedHeap[T] {
+	return &IndexedHeap[T]{
+		data: []*Handle[T]{},
+		less: less,
+	}
+}
+
+// NewIndexedMin constructs an empty indexed heap with the smallest values towards the top.
+func NewIndexedMin[T cmp.Ordered]() *IndexedHeap[T] {
+	return NewIndexed(cmp.Less[T])
+}
+
+// NewIndexedMax constructs an empty indexed heap with the largest values towards the top.
+func NewIndexedMax[T cmp.Ordered]() *IndexedHeap[T] {
+	return NewIndexed(greater[T])
+}
+
+// Add inserts a new value into the IndexedHeap and returns a handle to it. After adding, the IndexedHeap fixes the
+// remaining values ordering.
+func (h *IndexedHeap[T]) Add(value T) *Handle[T] {
+	handle := &Handle[T]{
+		value: value,
+		index: len(h.data),
+		heap:  h,
+	}
+
+	h.data = append(h.data, handle)
+	h.bubbleUp(handle.index)
+
+	return handle
+}
+
+// Pop removes the top value from the IndexedHeap. The handle of the popped value is no longer contained in the heap.
+func (h *IndexedHeap[T]) Pop() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("indexed heap: pop called on empty heap")
+	}
+
+	return h.removeAt(0), nil
+}
+
+// GetFirstValue returns the top value of the heap. This does not remove the value from the heap.
+// Similar to a peek in a queue.
+func (h *IndexedHeap[T]) GetFirstValue() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("indexed heap: get first value called on empty heap")
+	}
+
+	return h.data[0].value, nil
+}
+
+// Length returns the number of values in the IndexedHeap.
+func (h *IndexedHeap[T]) Length() int {
+	return len(h.data)
+}
+
+// All returns an iterator over the values of the IndexedHeap in heap order, which starts with the top value but is
+// otherwise unsorted. The heap must not be changed while iterating over it.
+func (h *IndexedHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, handle := range h.data {
+			if !yield(handle.value) {
+				return
+			}
+		}
+	}
+}
+
+// Contains reports whether the handle refers to a value that is still in this heap.
+func (h *IndexedHeap[T]) Contains(handle *Handle[T]) bool {
+	return handle != nil && handle.heap == h && handle.index >= 0 && handle.index < len(h.data) &&
+		h.data[handle.index] == handle
+}
+
+// Update replaces the value the handle refers to and moves it to its new place in the heap. This covers both
+// decreasing and increasing the key of a value.
+func (h *IndexedHeap[T]) Update(handle *Handle[T], value T) error {
+	if !h.Contains(handle) {
+		return fmt.Errorf("indexed heap: update called with handle not in heap")
+	}
+
+	handle.value = value
+	h.fix(handle.index)
+
+	return nil
+}
+
+// Remove takes the value the handle refers to out of the heap and returns it.
+func (h *IndexedHeap[T]) Remove(handle *Handle[T]) (T, error) {
+	if !h.Contains(handle) {
+		var zero T
+		return zero, fmt.Errorf("indexed heap: remove called with handle not in heap")
+	}
+
+	return h.removeAt(handle.index), nil
+}
+
+// removeAt takes out the handle at the given index by moving the last handle into its place and fixing the ordering.
+func (h *IndexedHeap[T]) removeAt(index int) T {
+	removed := h.data[index]
+	last := len(h.data) - 1
+
+	if index != last {
+		h.swap(index, last)
+	}
+
+	h.data[last] = nil // clear the slot so the handle can be garbage collected
+	h.data = h.data[:last]
+	removed.index = -1
+
+	if index < len(h.data) {
+		h.fix(index)
+	}
+
+	return removed.value
+}
+
+// fix moves the handle at the given index either up or down until it is back in the correct place.
+func (h *IndexedHeap[T]) fix(index int) {
+	if index > 0 && h.less(h.data[index].value, h.data[getParentIndex(index)].value) {
+		h.bubbleUp(index)
+		return
+	}
+
+	h.bubbleDown(index)
+}
+
+func (h *IndexedHeap[T]) bubbleUp(index int) {
+	for index > 0 {
+		parentIndex := getParentIndex(index)
+
+		if !h.less(h.data[index].value, h.data[parentIndex].value) {
+			// the value is now in the correct place and is bubbled up; we are done
+			return
+		}
+
+		h.swap(parentIndex, index)
+		index = parentIndex
+	}
+}
+
+func (h *IndexedHeap[T]) bubbleDown(index int) {
+	for getLeftIndex(index) < len(h.data) {
+		firstChildIndex := getLeftIndex(index)
+		if right := getRightIndex(index); right < len(h.data) && h.less(h.data[right].value, h.data[firstChildIndex].value) {
+			firstChildIndex = right
+		}
+
+		if !h.less(h.data[firstChildIndex].value, h.data[index].value) {
+			// the value is now in the correct place and is bubbled down; we are done
+			return
+		}
+
+		h.swap(firstChildIndex, index)
+		index = firstChildIndex
+	}
+}
+
+// swap exchanges two handles and keeps their tracked positions in step.
+func (h *IndexedHeap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.data[i].index = i
+	h.data[j].index = j
+}
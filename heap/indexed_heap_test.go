@@ -0,0 +1,272 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+func TestIndexedHeap_AddPop(t *testing.T) {
+	type scenario struct {
+		name          string
+		input         []int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "pop from empty heap",
+			input:         []int{},
+			expectedOrder: []int{},
+		},
+		{
+			name:          "pop in ascending order",
+			input:         []int{4, 1, 3, 5, 2, 1},
+			expectedOrder: []int{1, 1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := NewIndexedMin[int]()
+			for _, value := range ts.input {
+				h.Add(value)
+			}
+
+			actualOrder := drainHeap[int](h)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+
+			_, actualErr := h.Pop()
+			expectedErr := fmt.Errorf("indexed heap: pop called on empty heap")
+			if !test.IsErrSame(actualErr, expectedErr) {
+				test.ReportTestFailure(t, actualErr, expectedErr)
+			}
+		})
+	}
+}
+
+func TestIndexedHeap_Update(t *testing.T) {
+	type scenario struct {
+		name          string
+		updateIndex   int // index into the added handles
+		newValue      int
+		expectedFirst int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "decrease key to the top",
+			updateIndex:   4,
+			newValue:      0,
+			expectedFirst: 0,
+			expectedOrder: []int{0, 10, 20, 30, 40},
+		},
+		{
+			name:          "increase key to the bottom",
+			updateIndex:   0,
+			newValue:      100,
+			expectedFirst: 20,
+			expectedOrder: []int{20, 30, 40, 50, 100},
+		},
+		{
+			name:          "update key in the middle",
+			updateIndex:   2,
+			newValue:      45,
+			expectedFirst: 10,
+			expectedOrder: []int{10, 20, 40, 45, 50},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := NewIndexedMin[int]()
+			handles := make([]*Handle[int], 0)
+			for _, value := range []int{10, 20, 30, 40, 50} {
+				handles = append(handles, h.Add(value))
+			}
+
+			if err := h.Update(handles[ts.updateIndex], ts.newValue); err != nil {
+				test.ReportTestFailure(t, err, nil)
+			}
+
+			if handles[ts.updateIndex].Value() != ts.newValue {
+				test.ReportTestFailure(t, handles[ts.updateIndex].Value(), ts.newValue)
+			}
+
+			actualFirst, _ := h.GetFirstValue()
+			if actualFirst != ts.expectedFirst {
+				test.ReportTestFailure(t, actualFirst, ts.expectedFirst)
+			}
+
+			actualOrder := drainHeap[int](h)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestIndexedHeap_Remove(t *testing.T) {
+	type scenario struct {
+		name          string
+		removeIndex   int // index into the added handles
+		expectedValue int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "remove the top",
+			removeIndex:   0,
+			expectedValue: 10,
+			expectedOrder: []int{20, 30, 40, 50},
+		},
+		{
+			name:          "remove from the middle",
+			removeIndex:   2,
+			expectedValue: 30,
+			expectedOrder: []int{10, 20, 40, 50},
+		},
+		{
+			name:          "remove the last added",
+			removeIndex:   4,
+			expectedValue: 50,
+			expectedOrder: []int{10, 20, 30, 40},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := NewIndexedMin[int]()
+			handles := make([]*Handle[int], 0)
+			for _, value := range []int{10, 20, 30, 40, 50} {
+				handles = append(handles, h.Add(value))
+			}
+
+			actualValue, actualErr := h.Remove(handles[ts.removeIndex])
+			if actualErr != nil {
+				test.ReportTestFailure(t, actualErr, nil)
+			}
+
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if h.Contains(handles[ts.removeIndex]) {
+				test.ReportTestFailure(t, true, false)
+			}
+
+			actualOrder := drainHeap[int](h)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestIndexedHeap_Contains(t *testing.T) {
+	h := NewIndexedMax[string]()
+	other := NewIndexedMax[string]()
+
+	kept := h.Add("kept")
+	popped := h.Add("popped")
+	foreign := other.Add("foreign")
+
+	_, _ = h.Pop()
+
+	type scenario struct {
+		name     string
+		handle   *Handle[string]
+		expected bool
+	}
+
+	testScenarios := []scenario{
+		{
+			name:     "handle still in heap",
+			handle:   kept,
+			expected: true,
+		},
+		{
+			name:     "handle popped from heap",
+			handle:   popped,
+			expected: false,
+		},
+		{
+			name:     "handle from another heap",
+			handle:   foreign,
+			expected: false,
+		},
+		{
+			name:     "nil handle",
+			handle:   nil,
+			expected: false,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actual := h.Contains(ts.handle)
+			if actual != ts.expected {
+				test.ReportTestFailure(t, actual, ts.expected)
+			}
+		})
+	}
+
+	t.Run("update and remove reject handles not in heap", func(t *testing.T) {
+		expectedUpdateErr := fmt.Errorf("indexed heap: update called with handle not in heap")
+		if actualErr := h.Update(popped, "again"); !test.IsErrSame(actualErr, expectedUpdateErr) {
+			test.ReportTestFailure(t, actualErr, expectedUpdateErr)
+		}
+
+		expectedRemoveErr := fmt.Errorf("indexed heap: remove called with handle not in heap")
+		if _, actualErr := h.Remove(foreign); !test.IsErrSame(actualErr, expectedRemoveErr) {
+			test.ReportTestFailure(t, actualErr, expectedRemoveErr)
+		}
+	})
+}
+
+func FuzzIndexedHeap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{9, 3, 0, 1, 4, 7, 2, 5})
+	f.Add(test.RandomBytes(42, 1000))
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// each byte adds, updates or removes by its remainder and picks a value and handle with the rest
+		h := NewIndexedMin[int]()
+		handles := make([]*Handle[int], 0)
+
+		for _, op := range ops {
+			value := int(op / 3)
+			switch {
+			case op%3 == 0 || len(handles) == 0:
+				handles = append(handles, h.Add(value))
+			case op%3 == 1:
+				if err := h.Update(handles[value%len(handles)], value); err != nil {
+					test.ReportTestFailure(t, err, nil)
+				}
+			default:
+				index := value % len(handles)
+				if _, err := h.Remove(handles[index]); err != nil {
+					test.ReportTestFailure(t, err, nil)
+				}
+				handles = slices.Delete(handles, index, index+1)
+			}
+		}
+
+		expectedOrder := make([]int, 0, len(handles))
+		for _, handle := range handles {
+			expectedOrder = append(expectedOrder, handle.Value())
+		}
+		slices.Sort(expectedOrder)
+
+		actualOrder := drainHeap[int](h)
+		if !cmp.Equal(actualOrder, expectedOrder) {
+			test.ReportTestFailure(t, actualOrder, expectedOrder)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// ensure LeftistHeap satisfies the Heaper interface at compile time
+var _ Heaper[int] = (*LeftistHeap[int])(nil)
+
+// LeftistHeap is a heap ordered by a less function that is built out of a tree of linked nodes. Every node keeps the
+// shortest path to an empty subtree on its right, which keeps the right spine short enough that adding, popping and
+// melding two leftist heaps all run in O(log n).
+type LeftistHeap[T any] struct {
+	root *leftistNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+// leftistNode holds a value, its children and the length of its right spine.
+type leftistNode[T any] struct {
+	value T
+	left  *leftistNode[T]
+	right *leftistNode[T]
+	rank  int // number of nodes on the path down the right spine
+}
+
+// NewLeftist constructs an empty leftist heap ordered by the given less function.
+func NewLeftist[T any](less func(a, b T) bool) *LeftistHeap[T] {
+	return &LeftistHeap[T]{
+		less: less,
+	}
+}
+
+// NewLeftistMin constructs an empty leftist heap with the smallest values towards the top.
+func NewLeftistMin[T cmp.Ordered]() *LeftistHeap[T] {
+	return NewLeftist(cmp.Less[T])
+}
+
+// NewLeftistMax constructs an empty leftist heap with the largest values towards the top.
+func NewLeftistMax[T cmp.Ordered]() *LeftistHeap[T] {
+	return NewLeftist(greater[T])
+}
+
+// Add inserts a new value into the LeftistHeap.
+func (h *LeftistHeap[T]) Add(value T) {
+	h.root = h.merge(h.root, &leftistNode[T]{value: value, rank: 1})
+	h.size++
+}
+
+// Pop removes the top value from the LeftistHeap by melding the two subtrees of the removed root.
+func (h *LeftistHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, fmt.Errorf("leftist heap: pop called on empty heap")
+	}
+
+	removed := h.root
+	h.root = h.merge(removed.left, removed.right)
+	h.size--
+
+	return removed.value, nil
+}
+
+// GetFirstValue returns the top value of the heap. This does not remove the value from the heap.
+// Similar to a peek in a queue.
+func (h *LeftistHeap[T]) GetFirstValue() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, fmt.Errorf("leftist heap: get first value called on empty heap")
+	}
+
+	return h.root.value, nil
+}
+
+// Length returns the number of values in the LeftistHeap.
+func (h *LeftistHeap[T]) Length() int {
+	return h.size
+}
+
+// All returns an iterator over the values of the LeftistHeap, starting with the top value and then walking the tree
+// of nodes depth first. The values are otherwise unsorted. The heap must not be changed while iterating over it.
+func (h *LeftistHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if h.root == nil {
+			return
+		}
+
+		stack := []*leftistNode[T]{h.root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(node.value) {
+				return
+			}
+
+			if node.right != nil {
+				stack = append(stack, node.right)
+			}
+			if node.left != nil {
+				stack = append(stack, node.left)
+			}
+		}
+	}
+}
+
+// Meld moves every value of other into the LeftistHeap in O(log n), leaving other empty. Both heaps are expected to
+// share the same ordering.
+func (h *LeftistHeap[T]) Meld(other *LeftistHeap[T]) {
+	if other == nil || other == h {
+		return
+	}
+
+	h.root = h.merge(h.root, other.root)
+	h.size += other.size
+
+	other.root = nil
+	other.size = 0
+}
+
+// merge joins two trees down their right spines, swapping children wherever needed to keep the shorter spine on the
+// right.
+func (h *LeftistHeap[T]) merge(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	a.right = h.merge(a.right, b)
+	if rank(a.left) < rank(a.right) {
+		a.left, a.right = a.right, a.left
+	}
+	a.rank = rank(a.right) + 1
+
+	return a
+}
+
+func rank[T any](node *leftistNode[T]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.rank
+}
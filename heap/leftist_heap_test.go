@@ -0,0 +1,151 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestLeftistHeap_AddPop(t *testing.T) {
+	type scenario struct {
+		name          string
+		heap          *LeftistHeap[int]
+		input         []int
+		expectedFirst int
+		expectedErr   error
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "empty heap",
+			heap:          NewLeftistMin[int](),
+			input:         []int{},
+			expectedErr:   fmt.Errorf("leftist heap: get first value called on empty heap"),
+			expectedOrder: []int{},
+		},
+		{
+			name:          "min leftist heap",
+			heap:          NewLeftistMin[int](),
+			input:         []int{8, 3, 5, 1, 9, 1, 4},
+			expectedFirst: 1,
+			expectedOrder: []int{1, 1, 3, 4, 5, 8, 9},
+		},
+		{
+			name:          "max leftist heap",
+			heap:          NewLeftistMax[int](),
+			input:         []int{8, 3, 5, 1, 9, 1, 4},
+			expectedFirst: 9,
+			expectedOrder: []int{9, 8, 5, 4, 3, 1, 1},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.input {
+				ts.heap.Add(value)
+			}
+
+			actualFirst, actualErr := ts.heap.GetFirstValue()
+			if !test.IsErrSame(actualErr, ts.expectedErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedErr)
+			}
+
+			if actualFirst != ts.expectedFirst {
+				test.ReportTestFailure(t, actualFirst, ts.expectedFirst)
+			}
+
+			actualOrder := drainHeap[int](ts.heap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+
+			_, actualErr = ts.heap.Pop()
+			expectedErr := fmt.Errorf("leftist heap: pop called on empty heap")
+			if !test.IsErrSame(actualErr, expectedErr) {
+				test.ReportTestFailure(t, actualErr, expectedErr)
+			}
+		})
+	}
+}
+
+func TestLeftistHeap_Meld(t *testing.T) {
+	type scenario struct {
+		name          string
+		first         []int
+		second        []int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "meld two empty heaps",
+			first:         []int{},
+			second:        []int{},
+			expectedOrder: []int{},
+		},
+		{
+			name:          "meld into empty heap",
+			first:         []int{},
+			second:        []int{3, 1, 2},
+			expectedOrder: []int{1, 2, 3},
+		},
+		{
+			name:          "meld empty heap",
+			first:         []int{3, 1, 2},
+			second:        []int{},
+			expectedOrder: []int{1, 2, 3},
+		},
+		{
+			name:          "meld interleaved heaps",
+			first:         []int{1, 5, 9, 3},
+			second:        []int{4, 0, 8, 6},
+			expectedOrder: []int{0, 1, 3, 4, 5, 6, 8, 9},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			first, second := NewLeftistMin[int](), NewLeftistMin[int]()
+			for _, value := range ts.first {
+				first.Add(value)
+			}
+			for _, value := range ts.second {
+				second.Add(value)
+			}
+
+			first.Meld(second)
+
+			if second.Length() != 0 {
+				test.ReportTestFailure(t, second.Length(), 0)
+			}
+
+			if first.Length() != len(ts.expectedOrder) {
+				test.ReportTestFailure(t, first.Length(), len(ts.expectedOrder))
+			}
+
+			actualOrder := drainHeap[int](first)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+
+	t.Run("meld with itself", func(t *testing.T) {
+		h := NewLeftistMin[int]()
+		h.Add(2)
+		h.Add(1)
+		h.Meld(h)
+
+		expectedOrder := []int{1, 2}
+		actualOrder := drainHeap[int](h)
+		if !cmp.Equal(actualOrder, expectedOrder) {
+			test.ReportTestFailure(t, actualOrder, expectedOrder)
+		}
+	})
+}
+
+func FuzzLeftistHeap(f *testing.F) {
+	fuzzMeldableHeap(f, 11, NewLeftistMin[int])
+}
@@ -0,0 +1,249 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// A min-max heap is laid out in a slice like the binary heap, but its levels alternate between min levels and max
+// levels, starting with a min level at the root. A value on a min level sorts no later than any of its descendants
+// and a value on a max level sorts no earlier than any of its descendants. This keeps the first value at the root and
+// the last value among the root's children.
+
+// ensure MinMaxHeap satisfies the Heaper interface at compile time
+var _ Heaper[int] = (*MinMaxHeap[int])(nil)
+
+// MinMaxHeap represents a double-ended heap ordered by a less function. Both the value that sorts first (the min) and
+// the value that sorts last (the max) can be peeked in O(1) and popped in O(log n). As a Heaper, Pop and GetFirstValue
+// work on the min.
+type MinMaxHeap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// NewMinMax constructs an empty min-max heap ordered by the given less function.
+func NewMinMax[T any](less func(a, b T) bool) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{
+		data: []T{},
+		less: less,
+	}
+}
+
+// NewOrderedMinMax constructs an empty min-max heap over an ordered type with the smallest value as the min.
+func NewOrderedMinMax[T cmp.Ordered]() *MinMaxHeap[T] {
+	return NewMinMax(cmp.Less[T])
+}
+
+// Add inserts a new value into the MinMaxHeap.
+func (h *MinMaxHeap[T]) Add(value T) {
+	h.data = append(h.data, value)
+	h.bubbleUp(len(h.data) - 1)
+}
+
+// Pop removes the min value from the MinMaxHeap. It is the same as PopMin.
+func (h *MinMaxHeap[T]) Pop() (T, error) {
+	return h.PopMin()
+}
+
+// GetFirstValue returns the min value of the heap without removing it. It is the same as PeekMin.
+func (h *MinMaxHeap[T]) GetFirstValue() (T, error) {
+	return h.PeekMin()
+}
+
+// Length returns the number of values in the MinMaxHeap.
+func (h *MinMaxHeap[T]) Length() int {
+	return len(h.data)
+}
+
+// All returns an iterator over the values of the MinMaxHeap in heap order, which starts with the min but is otherwise
+// unsorted. The heap must not be changed while iterating over it.
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range h.data {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// PeekMin returns the value that sorts first according to less without removing it.
+func (h *MinMaxHeap[T]) PeekMin() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("min max heap: peek min called on empty heap")
+	}
+
+	return h.data[0], nil
+}
+
+// PeekMax returns the value that sorts last according to less without removing it.
+func (h *MinMaxHeap[T]) PeekMax() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("min max heap: peek max called on empty heap")
+	}
+
+	return h.data[h.maxIndex()], nil
+}
+
+// PopMin removes the value that sorts first according to less.
+func (h *MinMaxHeap[T]) PopMin() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("min max heap: pop min called on empty heap")
+	}
+
+	return h.removeAt(0), nil
+}
+
+// PopMax removes the value that sorts last according to less.
+func (h *MinMaxHeap[T]) PopMax() (T, error) {
+	if len(h.data) <= 0 {
+		var zero T
+		return zero, fmt.Errorf("min max heap: pop max called on empty heap")
+	}
+
+	return h.removeAt(h.maxIndex()), nil
+}
+
+// maxIndex returns the index of the max value, which is the root when it has no children or else one of its children.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case len(h.data) == 1:
+		return 0
+	case len(h.data) == 2 || !h.less(h.data[1], h.data[2]):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// removeAt takes out the value at the given index by moving the last value into its place and bubbling it down.
+func (h *MinMaxHeap[T]) removeAt(index int) T {
+	var zero T
+
+	removed := h.data[index]
+	last := len(h.data) - 1
+
+	h.data[index] = h.data[last]
+	h.data[last] = zero // clear the slot so the value can be garbage collected
+	h.data = h.data[:last]
+
+	if index < len(h.data) {
+		h.bubbleDown(index)
+	}
+
+	return removed
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+
+	parentIndex := getParentIndex(index)
+
+	if isMinLevel(index) {
+		if h.less(h.data[parentIndex], h.data[index]) {
+			// the value belongs among the max levels above
+			h.data[parentIndex], h.data[index] = h.data[index], h.data[parentIndex]
+			h.bubbleUpLevels(parentIndex, h.greater)
+			return
+		}
+		h.bubbleUpLevels(index, h.less)
+		return
+	}
+
+	if h.less(h.data[index], h.data[parentIndex]) {
+		// the value belongs among the min levels above
+		h.data[parentIndex], h.data[index] = h.data[index], h.data[parentIndex]
+		h.bubbleUpLevels(parentIndex, h.less)
+		return
+	}
+	h.bubbleUpLevels(index, h.greater)
+}
+
+// bubbleUpLevels moves the value up through the levels of its own kind, skipping a level each step, for as long as
+// it comes before its grandparent according to before.
+func (h *MinMaxHeap[T]) bubbleUpLevels(index int, before func(a, b T) bool) {
+	for index > 2 {
+		grandparentIndex := getParentIndex(getParentIndex(index))
+
+		if !before(h.data[index], h.data[grandparentIndex]) {
+			// the value is now in the correct place and is bubbled up; we are done
+			return
+		}
+
+		h.data[grandparentIndex], h.data[index] = h.data[index], h.data[grandparentIndex]
+		index = grandparentIndex
+	}
+}
+
+func (h *MinMaxHeap[T]) bubbleDown(index int) {
+	if isMinLevel(index) {
+		h.bubbleDownLevels(index, h.less)
+		return
+	}
+
+	h.bubbleDownLevels(index, h.greater)
+}
+
+// bubbleDownLevels moves the value down through the levels of its own kind. At each step the value is compared with
+// whichever of its children and grandchildren comes first according to before.
+func (h *MinMaxHeap[T]) bubbleDownLevels(index int, before func(a, b T) bool) {
+	for getLeftIndex(index) < len(h.data) {
+		firstIndex := h.firstDescendantIndex(index, before)
+
+		if !before(h.data[firstIndex], h.data[index]) {
+			// the value is now in the correct place and is bubbled down; we are done
+			return
+		}
+
+		h.data[firstIndex], h.data[index] = h.data[index], h.data[firstIndex]
+
+		if firstIndex <= getRightIndex(index) {
+			// a child is only the first when it has no children of its own, so there is nothing further down
+			return
+		}
+
+		// the value moved down to a grandchild; make sure it still belongs below its new parent
+		parentIndex := getParentIndex(firstIndex)
+		if before(h.data[parentIndex], h.data[firstIndex]) {
+			h.data[parentIndex], h.data[firstIndex] = h.data[firstIndex], h.data[parentIndex]
+		}
+
+		index = firstIndex
+	}
+}
+
+// firstDescendantIndex returns the index of whichever child or grandchild comes first according to before.
+func (h *MinMaxHeap[T]) firstDescendantIndex(index int, before func(a, b T) bool) int {
+	first := getLeftIndex(index)
+
+	for child := getLeftIndex(index); child <= getRightIndex(index) && child < len(h.data); child++ {
+		if before(h.data[child], h.data[first]) {
+			first = child
+		}
+
+		for grandchild := getLeftIndex(child); grandchild <= getRightIndex(child) && grandchild < len(h.data); grandchild++ {
+			if before(h.data[grandchild], h.data[first]) {
+				first = grandchild
+			}
+		}
+	}
+
+	return first
+}
+
+// greater is the opposite ordering of less.
+func (h *MinMaxHeap[T]) greater(a, b T) bool {
+	return h.less(b, a)
+}
+
+// isMinLevel reports whether the index sits on a min level, which are the levels at an even depth.
+func isMinLevel(index int) bool {
+	return (bits.Len(uint(index+1))-1)%2 == 0
+}
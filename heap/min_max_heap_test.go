@@ -0,0 +1,180 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+func TestMinMaxHeap_Peek(t *testing.T) {
+	type scenario struct {
+		name           string
+		input          []int
+		expectedMin    int
+		expectedMinErr error
+		expectedMax    int
+		expectedMaxErr error
+	}
+
+	testScenarios := []scenario{
+		{
+			name:           "peek on empty heap",
+			input:          []int{},
+			expectedMinErr: fmt.Errorf("min max heap: peek min called on empty heap"),
+			expectedMaxErr: fmt.Errorf("min max heap: peek max called on empty heap"),
+		},
+		{
+			name:        "peek on single value",
+			input:       []int{4},
+			expectedMin: 4,
+			expectedMax: 4,
+		},
+		{
+			name:        "peek on two values",
+			input:       []int{4, 9},
+			expectedMin: 4,
+			expectedMax: 9,
+		},
+		{
+			name:        "peek on many values",
+			input:       []int{12, 7, 30, 2, 45, 19, 3, 45, 0},
+			expectedMin: 0,
+			expectedMax: 45,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := NewOrderedMinMax[int]()
+			for _, value := range ts.input {
+				h.Add(value)
+			}
+
+			actualMin, actualErr := h.PeekMin()
+			if !test.IsErrSame(actualErr, ts.expectedMinErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedMinErr)
+			}
+			if actualMin != ts.expectedMin {
+				test.ReportTestFailure(t, actualMin, ts.expectedMin)
+			}
+
+			actualMax, actualErr := h.PeekMax()
+			if !test.IsErrSame(actualErr, ts.expectedMaxErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedMaxErr)
+			}
+			if actualMax != ts.expectedMax {
+				test.ReportTestFailure(t, actualMax, ts.expectedMax)
+			}
+
+			if h.Length() != len(ts.input) {
+				test.ReportTestFailure(t, h.Length(), len(ts.input))
+			}
+		})
+	}
+}
+
+func TestMinMaxHeap_Pop(t *testing.T) {
+	type scenario struct {
+		name          string
+		input         []int
+		popMax        bool
+		expectedOrder []int
+	}
+
+	input := []int{12, 7, 30, 2, 45, 19, 3, 45, 0, 8, 27}
+
+	testScenarios := []scenario{
+		{
+			name:          "pop min until empty",
+			input:         input,
+			expectedOrder: []int{0, 2, 3, 7, 8, 12, 19, 27, 30, 45, 45},
+		},
+		{
+			name:          "pop max until empty",
+			input:         input,
+			popMax:        true,
+			expectedOrder: []int{45, 45, 30, 27, 19, 12, 8, 7, 3, 2, 0},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			h := NewOrderedMinMax[int]()
+			for _, value := range ts.input {
+				h.Add(value)
+			}
+
+			actualOrder := make([]int, 0)
+			for h.Length() > 0 {
+				var value int
+				if ts.popMax {
+					value, _ = h.PopMax()
+				} else {
+					value, _ = h.Pop()
+				}
+				actualOrder = append(actualOrder, value)
+			}
+
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+
+	t.Run("pop on empty heap", func(t *testing.T) {
+		h := NewOrderedMinMax[int]()
+
+		_, actualErr := h.PopMin()
+		expectedErr := fmt.Errorf("min max heap: pop min called on empty heap")
+		if !test.IsErrSame(actualErr, expectedErr) {
+			test.ReportTestFailure(t, actualErr, expectedErr)
+		}
+
+		_, actualErr = h.PopMax()
+		expectedErr = fmt.Errorf("min max heap: pop max called on empty heap")
+		if !test.IsErrSame(actualErr, expectedErr) {
+			test.ReportTestFailure(t, actualErr, expectedErr)
+		}
+	})
+}
+
+func FuzzMinMaxHeap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{12, 7, 30, 2, 45, 19, 3, 45, 0})
+	f.Add(test.RandomBytes(3, 2000))
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// each byte pops from either end or adds by its remainder and picks a value with the rest, and the pops are
+		// checked against a sorted copy of the values that should be left
+		h := NewOrderedMinMax[int]()
+		expected := make([]int, 0)
+
+		for _, op := range ops {
+			switch value := int(op / 4); {
+			case op%4 < 2 || len(expected) == 0:
+				h.Add(value)
+				index, _ := slices.BinarySearch(expected, value)
+				expected = slices.Insert(expected, index, value)
+			case op%4 == 2:
+				actual, _ := h.PopMin()
+				if actual != expected[0] {
+					test.ReportTestFailure(t, actual, expected[0])
+				}
+				expected = expected[1:]
+			default:
+				actual, _ := h.PopMax()
+				if actual != expected[len(expected)-1] {
+					test.ReportTestFailure(t, actual, expected[len(expected)-1])
+				}
+				expected = expected[:len(expected)-1]
+			}
+		}
+
+		actualOrder := drainHeap[int](h)
+		if !cmp.Equal(actualOrder, expected) {
+			test.ReportTestFailure(t, actualOrder, expected)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// ensure PairingHeap satisfies the Heaper interface at compile time
+var _ Heaper[int] = (*PairingHeap[int])(nil)
+
+// PairingHeap is a heap ordered by a less function that is built out of a tree of linked nodes rather than a slice.
+// Adding values and melding two pairing heaps together both run in O(1), while popping runs in amortized O(log n).
+type PairingHeap[T any] struct {
+	root *pairingNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+// pairingNode holds a value along with its leftmost child and the next of its siblings.
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+}
+
+// NewPairing constructs an empty pairing heap ordered by the given less function.
+func NewPairing[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{
+		less: less,
+	}
+}
+
+// NewPairingMin constructs an empty pairing heap with the smallest values towards the top.
+func NewPairingMin[T cmp.Ordered]() *PairingHeap[T] {
+	return NewPairing(cmp.Less[T])
+}
+
+// NewPairingMax constructs an empty pairing heap with the largest values towards the top.
+func NewPairingMax[T cmp.Ordered]() *PairingHeap[T] {
+	return NewPairing(greater[T])
+}
+
+// Add inserts a new value into the PairingHeap.
+func (h *PairingHeap[T]) Add(value T) {
+	h.root = h.link(h.root, &pairingNode[T]{value: value})
+	h.size++
+}
+
+// Pop removes the top value from the PairingHeap. The children of the removed root are melded back together in pairs.
+func (h *PairingHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, fmt.Errorf("pairing heap: pop called on empty heap")
+	}
+
+	removed := h.root
+	h.root = h.mergePairs(removed.child)
+	h.size--
+
+	return removed.value, nil
+}
+
+// GetFirstValue returns the top value of the heap. This does not remove the value from the heap.
+// Similar to a peek in a queue.
+func (h *PairingHeap[T]) GetFirstValue() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, fmt.Errorf("pairing heap: get first value called on empty heap")
+	}
+
+	return h.root.value, nil
+}
+
+// Length returns the number of values in the PairingHeap.
+func (h *PairingHeap[T]) Length() int {
+	return h.size
+}
+
+// All returns an iterator over the values of the PairingHeap, starting with the top value and then walking the tree
+// of nodes depth first. The values are otherwise unsorted. The heap must not be changed while iterating over it.
+func (h *PairingHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if h.root == nil {
+			return
+		}
+
+		// walk with an explicit stack, as the sibling chains can be far too long to recurse through
+		stack := []*pairingNode[T]{h.root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(node.value) {
+				return
+			}
+
+			if node.sibling != nil {
+				stack = append(stack, node.sibling)
+			}
+			if node.child != nil {
+				stack = append(stack, node.child)
+			}
+		}
+	}
+}
+
+// Meld moves every value of other into the PairingHeap in O(1), leaving other empty. Both heaps are expected to share
+// the same ordering.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == nil || other == h {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+
+	other.root = nil
+	other.size = 0
+}
+
+// link joins two trees by making the root that sorts later the leftmost child of the other root.
+func (h *PairingHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.sibling = a.child
+	a.child = b
+
+	return a
+}
+
+// mergePairs melds a list of siblings into a single tree using the standard two pass approach: link siblings in pairs
+// from left to right, then link the resulting trees together from right to left.
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	pairs := make([]*pairingNode[T], 0)
+
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			pairs = append(pairs, a)
+			break
+		}
+
+		first = b.sibling
+		a.sibling, b.sibling = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+
+	return root
+}
@@ -0,0 +1,205 @@
+package heap
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"slices"
+	"testing"
+)
+
+func TestPairingHeap_AddPop(t *testing.T) {
+	type scenario struct {
+		name          string
+		heap          *PairingHeap[int]
+		input         []int
+		expectedFirst int
+		expectedErr   error
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "empty heap",
+			heap:          NewPairingMin[int](),
+			input:         []int{},
+			expectedErr:   fmt.Errorf("pairing heap: get first value called on empty heap"),
+			expectedOrder: []int{},
+		},
+		{
+			name:          "min pairing heap",
+			heap:          NewPairingMin[int](),
+			input:         []int{8, 3, 5, 1, 9, 1, 4},
+			expectedFirst: 1,
+			expectedOrder: []int{1, 1, 3, 4, 5, 8, 9},
+		},
+		{
+			name:          "max pairing heap",
+			heap:          NewPairingMax[int](),
+			input:         []int{8, 3, 5, 1, 9, 1, 4},
+			expectedFirst: 9,
+			expectedOrder: []int{9, 8, 5, 4, 3, 1, 1},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.input {
+				ts.heap.Add(value)
+			}
+
+			actualFirst, actualErr := ts.heap.GetFirstValue()
+			if !test.IsErrSame(actualErr, ts.expectedErr) {
+				test.ReportTestFailure(t, actualErr, ts.expectedErr)
+			}
+
+			if actualFirst != ts.expectedFirst {
+				test.ReportTestFailure(t, actualFirst, ts.expectedFirst)
+			}
+
+			actualOrder := drainHeap[int](ts.heap)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+
+			_, actualErr = ts.heap.Pop()
+			expectedErr := fmt.Errorf("pairing heap: pop called on empty heap")
+			if !test.IsErrSame(actualErr, expectedErr) {
+				test.ReportTestFailure(t, actualErr, expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairingHeap_Meld(t *testing.T) {
+	type scenario struct {
+		name          string
+		first         []int
+		second        []int
+		expectedOrder []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:          "meld two empty heaps",
+			first:         []int{},
+			second:        []int{},
+			expectedOrder: []int{},
+		},
+		{
+			name:          "meld into empty heap",
+			first:         []int{},
+			second:        []int{3, 1, 2},
+			expectedOrder: []int{1, 2, 3},
+		},
+		{
+			name:          "meld empty heap",
+			first:         []int{3, 1, 2},
+			second:        []int{},
+			expectedOrder: []int{1, 2, 3},
+		},
+		{
+			name:          "meld interleaved heaps",
+			first:         []int{1, 5, 9, 3},
+			second:        []int{4, 0, 8, 6},
+			expectedOrder: []int{0, 1, 3, 4, 5, 6, 8, 9},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			first, second := NewPairingMin[int](), NewPairingMin[int]()
+			for _, value := range ts.first {
+				first.Add(value)
+			}
+			for _, value := range ts.second {
+				second.Add(value)
+			}
+
+			first.Meld(second)
+
+			if second.Length() != 0 {
+				test.ReportTestFailure(t, second.Length(), 0)
+			}
+
+			if first.Length() != len(ts.expectedOrder) {
+				test.ReportTestFailure(t, first.Length(), len(ts.expectedOrder))
+			}
+
+			actualOrder := drainHeap[int](first)
+			if !cmp.Equal(actualOrder, ts.expectedOrder) {
+				test.ReportTestFailure(t, actualOrder, ts.expectedOrder)
+			}
+		})
+	}
+
+	t.Run("meld with itself", func(t *testing.T) {
+		h := NewPairingMin[int]()
+		h.Add(2)
+		h.Add(1)
+		h.Meld(h)
+
+		expectedOrder := []int{1, 2}
+		actualOrder := drainHeap[int](h)
+		if !cmp.Equal(actualOrder, expectedOrder) {
+			test.ReportTestFailure(t, actualOrder, expectedOrder)
+		}
+	})
+}
+
+func FuzzPairingHeap(f *testing.F) {
+	fuzzMeldableHeap(f, 7, NewPairingMin[int])
+}
+
+// meldableHeap is the part of the pairing and leftist heaps that fuzzMeldableHeap drives.
+type meldableHeap[H any] interface {
+	Add(value int)
+	Pop() (int, error)
+	Length() int
+	Meld(other H)
+}
+
+// fuzzMeldableHeap runs the fuzz input against a heap as a mix of adds, pops and melds, checking every pop against a
+// sorted copy of the values that should be left.
+func fuzzMeldableHeap[H meldableHeap[H]](f *testing.F, seed int64, newHeap func() H) {
+	f.Add([]byte{})
+	f.Add([]byte{8, 3, 5, 1, 9, 0, 4, 2})
+	f.Add(test.RandomBytes(seed, 1000))
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// each byte pops, melds or adds by its remainder and picks a value with the rest
+		h := newHeap()
+		expected := make([]int, 0)
+
+		for _, op := range ops {
+			value := int(op / 4)
+			switch {
+			case op%4 == 0 && len(expected) > 0:
+				actual, _ := h.Pop()
+				if actual != expected[0] {
+					test.ReportTestFailure(t, actual, expected[0])
+				}
+				expected = expected[1:]
+				continue
+			case op%4 == 1:
+				other := newHeap()
+				other.Add(value)
+				h.Meld(other)
+			default:
+				h.Add(value)
+			}
+
+			index, _ := slices.BinarySearch(expected, value)
+			expected = slices.Insert(expected, index, value)
+		}
+
+		if h.Length() != len(expected) {
+			test.ReportTestFailure(t, h.Length(), len(expected))
+		}
+
+		actualOrder := drainHeap[int](h)
+		if !cmp.Equal(actualOrder, expected) {
+			test.ReportTestFailure(t, actualOrder, expected)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package heap
+
+import (
+	"iter"
+	"slices"
+)
+
+// Sort sorts the given slice in place in ascending order according to less using heap sort. This runs in
+// O(n log n) without allocating; the sort is not stable.
+func Sort[T any](values []T, less func(a, b T) bool) {
+	// a heap with the largest value on top lets each pop fill the slice from the back
+	h := Heap[T]{
+		data: values,
+		less: reverse(less),
+	}
+	h.heapify()
+
+	for end := len(values) - 1; end > 0; end-- {
+		h.data[0], h.data[end] = h.data[end], h.data[0]
+		h.data = h.data[:end]
+		h.bubbleDown(0)
+	}
+}
+
+// TopK returns the k greatest values of the slice according to less, greatest first.
+func TopK[T any](values []T, k int, less func(a, b T) bool) []T {
+	return selectK(slices.Values(values), k, min(k, len(values)), less)
+}
+
+// BottomK returns the k least values of the slice according to less, least first.
+func BottomK[T any](values []T, k int, less func(a, b T) bool) []T {
+	return selectK(slices.Values(values), k, min(k, len(values)), reverse(less))
+}
+
+// TopKSeq returns the k greatest values yielded by seq according to less, greatest first. Values are streamed
+// through a heap holding at most k of them, so this runs in O(n log k) time and O(k) memory.
+func TopKSeq[T any](seq iter.Seq[T], k int, less func(a, b T) bool) []T {
+	// keep the least of the current top k on top of the heap so it is the first to be replaced
+	return selectK(seq, k, 0, less)
+}
+
+// BottomKSeq returns the k least values yielded by seq according to less, least first. Values are streamed through
+// a heap holding at most k of them, so this runs in O(n log k) time and O(k) memory.
+func BottomKSeq[T any](seq iter.Seq[T], k int, less func(a, b T) bool) []T {
+	// keep the largest of the current bottom k on top of the heap so it is the first to be replaced
+	return selectK(seq, k, 0, reverse(less))
+}
+
+// selectK keeps the k values that sort last according to less and returns them in reverse order of less. The heap
+// starts with room for capacity values and grows as values arrive, so a large k costs nothing until it is filled.
+func selectK[T any](seq iter.Seq[T], k, capacity int, less func(a, b T) bool) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	h := Heap[T]{
+		data: make([]T, 0, capacity),
+		less: less,
+	}
+
+	seq(func(value T) bool {
+		if len(h.data) < k {
+			h.Add(value)
+		} else if less(h.data[0], value) {
+			h.data[0] = value
+			h.bubbleDown(0)
+		}
+		return true
+	})
+
+	// the kept values come back with the one that sorts last according to less first
+	result := h.data
+	Sort(result, reverse(less))
+
+	return result
+}
+
+// reverse returns the opposite ordering of less.
+func reverse[T any](less func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
@@ -0,0 +1,195 @@
+package heap
+
+import (
+	"cmp"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	type scenario struct {
+		name     string
+		input    []int
+		less     func(a, b int) bool
+		expected []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:     "sort empty slice",
+			input:    []int{},
+			less:     cmp.Less[int],
+			expected: []int{},
+		},
+		{
+			name:     "sort single value",
+			input:    []int{1},
+			less:     cmp.Less[int],
+			expected: []int{1},
+		},
+		{
+			name:     "sort ascending",
+			input:    []int{5, 2, 9, 1, 5, 6, -3},
+			less:     cmp.Less[int],
+			expected: []int{-3, 1, 2, 5, 5, 6, 9},
+		},
+		{
+			name:     "sort descending",
+			input:    []int{5, 2, 9, 1, 5, 6, -3},
+			less:     greater[int],
+			expected: []int{9, 6, 5, 5, 2, 1, -3},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			Sort(ts.input, ts.less)
+
+			if !gocmp.Equal(ts.input, ts.expected) {
+				test.ReportTestFailure(t, ts.input, ts.expected)
+			}
+		})
+	}
+}
+
+func TestTopK(t *testing.T) {
+	type scenario struct {
+		name     string
+		input    []int
+		k        int
+		expected []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:     "k of zero",
+			input:    []int{1, 2, 3},
+			k:        0,
+			expected: []int{},
+		},
+		{
+			name:     "k larger than input",
+			input:    []int{2, 3, 1},
+			k:        5,
+			expected: []int{3, 2, 1},
+		},
+		{
+			name:     "k far larger than input",
+			input:    []int{2, 3, 1},
+			k:        math.MaxInt,
+			expected: []int{3, 2, 1},
+		},
+		{
+			name:     "top three",
+			input:    []int{7, 1, 9, 4, 9, 3, 8, 2},
+			k:        3,
+			expected: []int{9, 9, 8},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actual := TopK(ts.input, ts.k, cmp.Less[int])
+
+			if !gocmp.Equal(actual, ts.expected) {
+				test.ReportTestFailure(t, actual, ts.expected)
+			}
+		})
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	type scenario struct {
+		name     string
+		input    []int
+		k        int
+		expected []int
+	}
+
+	testScenarios := []scenario{
+		{
+			name:     "negative k",
+			input:    []int{1, 2, 3},
+			k:        -1,
+			expected: []int{},
+		},
+		{
+			name:     "k larger than input",
+			input:    []int{2, 3, 1},
+			k:        5,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "k far larger than input",
+			input:    []int{2, 3, 1},
+			k:        math.MaxInt,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "bottom three",
+			input:    []int{7, 1, 9, 4, 1, 3, 8, 2},
+			k:        3,
+			expected: []int{1, 1, 2},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actual := BottomK(ts.input, ts.k, cmp.Less[int])
+
+			if !gocmp.Equal(actual, ts.expected) {
+				test.ReportTestFailure(t, actual, ts.expected)
+			}
+		})
+	}
+}
+
+func TestTopKSeq(t *testing.T) {
+	type score struct {
+		Name  string
+		Score int
+	}
+
+	// generate scores on the fly rather than holding them all in memory
+	scores := func(yield func(score) bool) {
+		for i := 0; i < 10_000; i++ {
+			if !yield(score{Name: "player", Score: (i * 7919) % 10_007}) {
+				return
+			}
+		}
+	}
+
+	byScore := func(a, b score) bool {
+		return a.Score < b.Score
+	}
+
+	all := make([]score, 0)
+	scores(func(s score) bool {
+		all = append(all, s)
+		return true
+	})
+	slices.SortFunc(all, func(a, b score) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	expectedTop := all[:5]
+	actualTop := TopKSeq(scores, 5, byScore)
+	if !gocmp.Equal(actualTop, expectedTop) {
+		test.ReportTestFailure(t, actualTop, expectedTop)
+	}
+
+	expectedBottom := []score{all[len(all)-1], all[len(all)-2], all[len(all)-3]}
+	actualBottom := BottomKSeq(scores, 3, byScore)
+	if !gocmp.Equal(actualBottom, expectedBottom) {
+		test.ReportTestFailure(t, actualBottom, expectedBottom)
+	}
+
+	// a k far beyond the number of values only holds what the sequence yields
+	actualAll := TopKSeq(scores, math.MaxInt, byScore)
+	if !gocmp.Equal(actualAll, all) {
+		test.ReportTestFailure(t, len(actualAll), len(all))
+	}
+}
@@ -0,0 +1,200 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/collection"
+	"iter"
+)
+
+// Deque is a double-ended queue backed by a ring buffer. Like the RingQueue, its buffer is always a power of 2 in size
+// so that positions wrap around with bit masking.
+
+// ensure Deque satisfies the Queue and Reversible interfaces at compile time
+var (
+	_ Queue[any]                 = (*Deque[any])(nil)
+	_ collection.Reversible[any] = (*Deque[any])(nil)
+)
+
+// Deque represents a double-ended queue where elements can be pushed and popped at either end in O(1). Any element
+// can also be read by its position from the front in O(1). As a Queue, it pushes to the back and pops from the front.
+type Deque[T any] struct {
+	buffer []T
+	head   int // index of the front element in the buffer
+	count  int // number of elements in the deque; NOT necessarily the length of the buffer
+}
+
+// NewDeque constructs a new, empty Deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{
+		buffer: make([]T, minRingQueueSize),
+	}
+}
+
+// Length gets the number of elements in the deque.
+func (d *Deque[T]) Length() int {
+	return d.count
+}
+
+// Push adds an element to the back of the deque.
+func (d *Deque[T]) Push(element T) {
+	d.PushBack(element)
+}
+
+// Pop removes and returns the element at the front of the deque. If the deque is empty, an error is returned.
+func (d *Deque[T]) Pop() (T, error) {
+	return d.PopFront()
+}
+
+// Peek returns the element at the front of the deque without removing it. If the deque is empty, an error is returned.
+func (d *Deque[T]) Peek() (T, error) {
+	return d.PeekFront()
+}
+
+// PushFront adds an element to the front of the deque.
+func (d *Deque[T]) PushFront(element T) {
+	d.grow()
+
+	d.head = (d.head - 1) & (len(d.buffer) - 1) // bitwise modulus using AND, which also wraps -1 to the end
+	d.buffer[d.head] = element
+	d.count++
+}
+
+// PushBack adds an element to the back of the deque.
+func (d *Deque[T]) PushBack(element T) {
+	d.grow()
+
+	d.buffer[d.index(d.count)] = element
+	d.count++
+}
+
+// PopFront removes and returns the element at the front of the deque. If the deque is empty, an error is returned.
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+
+	if d.count <= 0 {
+		return zero, fmt.Errorf("pop front attempted on empty deque")
+	}
+
+	result := d.buffer[d.head]
+	d.buffer[d.head] = zero // clear result from deque so it can be garbage collected
+	d.head = d.index(1)
+	d.count--
+
+	d.shrink()
+
+	return result, nil
+}
+
+// PopBack removes and returns the element at the back of the deque. If the deque is empty, an error is returned.
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+
+	if d.count <= 0 {
+		return zero, fmt.Errorf("pop back attempted on empty deque")
+	}
+
+	tail := d.index(d.count - 1)
+	result := d.buffer[tail]
+	d.buffer[tail] = zero // clear result from deque so it can be garbage collected
+	d.count--
+
+	d.shrink()
+
+	return result, nil
+}
+
+// PeekFront returns the element at the front of the deque without removing it. If the deque is empty, an error is
+// returned.
+func (d *Deque[T]) PeekFront() (T, error) {
+	if d.count <= 0 {
+		var zero T
+		return zero, fmt.Errorf("peek front attempted on empty deque")
+	}
+
+	return d.buffer[d.head], nil
+}
+
+// PeekBack returns the element at the back of the deque without removing it. If the deque is empty, an error is
+// returned.
+func (d *Deque[T]) PeekBack() (T, error) {
+	if d.count <= 0 {
+		var zero T
+		return zero, fmt.Errorf("peek back attempted on empty deque")
+	}
+
+	return d.buffer[d.index(d.count-1)], nil
+}
+
+// Get returns the element at position i counting from the front of the deque, where 0 is the front. An error is
+// returned when i is out of bounds.
+func (d *Deque[T]) Get(i int) (T, error) {
+	if i < 0 || i >= d.count {
+		var zero T
+		return zero, fmt.Errorf("index %d out of bounds for deque of length %d", i, d.count)
+	}
+
+	return d.buffer[d.index(i)], nil
+}
+
+// All returns an iterator over the elements of the deque from front to back. The deque must not be changed while
+// iterating over it.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.count; i++ {
+			if !yield(d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the deque from back to front. The deque must not be changed while
+// iterating over it.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.count - 1; i >= 0; i-- {
+			if !yield(d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index maps position i counting from the front of the deque to its index in the buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buffer) - 1) // bitwise modulus using AND
+}
+
+// grow makes room for one more element, initializing the buffer if needed and doubling it once it is full.
+func (d *Deque[T]) grow() {
+	// if the buffer is uninitialized, let's initialize it
+	if d.buffer == nil {
+		d.buffer = make([]T, minRingQueueSize)
+	}
+
+	if d.count == len(d.buffer) {
+		d.resize(len(d.buffer) << 1)
+	}
+}
+
+// shrink halves the buffer once it is bigger than the minimum size and only a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buffer) > minRingQueueSize && (d.count<<2) == len(d.buffer) {
+		d.resize(len(d.buffer) >> 1)
+	}
+}
+
+// resize moves the elements into a new buffer of the given size, starting from the front of the new buffer.
+func (d *Deque[T]) resize(size int) {
+	newBuffer := make([]T, size)
+
+	if d.head+d.count <= len(d.buffer) {
+		copy(newBuffer, d.buffer[d.head:d.head+d.count])
+	} else {
+		n := copy(newBuffer, d.buffer[d.head:])
+		copy(newBuffer[n:], d.buffer[:d.count-n])
+	}
+
+	d.head = 0
+	d.buffer = newBuffer
+}
@@ -0,0 +1,299 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+// dequeValues reads every value of the deque from front to back without changing it.
+func dequeValues(t *testing.T, d *Deque[int]) []int {
+	t.Helper()
+
+	values := make([]int, 0, d.Length())
+	for i := 0; i < d.Length(); i++ {
+		value, err := d.Get(i)
+		if err != nil {
+			t.Fatalf("scenario: %s \n\t unexpected error: %v", t.Name(), err)
+		}
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func TestDeque_Push(t *testing.T) {
+	type scenario struct {
+		name           string
+		deque          *Deque[int]
+		pushFront      []int
+		pushBack       []int
+		expectedValues []int
+	}
+
+	dequeWithValues := NewDeque[int]()
+	dequeWithValues.PushBack(2)
+	dequeWithValues.PushBack(3)
+
+	testScenarios := []scenario{
+		{
+			name:           "push back onto empty struct",
+			deque:          &Deque[int]{}, // create empty struct without constructor
+			pushBack:       []int{1, 2, 3},
+			expectedValues: []int{1, 2, 3},
+		},
+		{
+			name:           "push front onto empty struct",
+			deque:          &Deque[int]{},
+			pushFront:      []int{1, 2, 3},
+			expectedValues: []int{3, 2, 1},
+		},
+		{
+			name:           "push onto both ends",
+			deque:          dequeWithValues,
+			pushFront:      []int{1, 0},
+			pushBack:       []int{4, 5},
+			expectedValues: []int{0, 1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, value := range ts.pushFront {
+				ts.deque.PushFront(value)
+			}
+			for _, value := range ts.pushBack {
+				ts.deque.PushBack(value)
+			}
+
+			if actualValues := dequeValues(t, ts.deque); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			if ts.deque.Length() != len(ts.expectedValues) {
+				test.ReportTestFailure(t, ts.deque.Length(), len(ts.expectedValues))
+			}
+		})
+	}
+}
+
+func TestDeque_PopPeek(t *testing.T) {
+	type scenario struct {
+		name           string
+		deque          *Deque[int]
+		fromFront      bool
+		expectedValue  int
+		expectedErr    error
+		expectedValues []int
+	}
+
+	dequeForFront := NewDeque[int]()
+	dequeForBack := NewDeque[int]()
+	for _, value := range []int{1, 2, 3} {
+		dequeForFront.PushBack(value)
+		dequeForBack.PushBack(value)
+	}
+
+	testScenarios := []scenario{
+		{
+			name:           "pop front from empty deque",
+			deque:          NewDeque[int](),
+			fromFront:      true,
+			expectedErr:    fmt.Errorf("pop front attempted on empty deque"),
+			expectedValues: []int{},
+		},
+		{
+			name:           "pop back from empty deque",
+			deque:          NewDeque[int](),
+			expectedErr:    fmt.Errorf("pop back attempted on empty deque"),
+			expectedValues: []int{},
+		},
+		{
+			name:           "pop front",
+			deque:          dequeForFront,
+			fromFront:      true,
+			expectedValue:  1,
+			expectedValues: []int{2, 3},
+		},
+		{
+			name:           "pop back",
+			deque:          dequeForBack,
+			expectedValue:  3,
+			expectedValues: []int{1, 2},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			peek, pop := ts.deque.PeekBack, ts.deque.PopBack
+			if ts.fromFront {
+				peek, pop = ts.deque.PeekFront, ts.deque.PopFront
+			}
+
+			peekedValue, peekErr := peek()
+			poppedValue, popErr := pop()
+
+			if peekedValue != ts.expectedValue || poppedValue != ts.expectedValue {
+				test.ReportTestFailure(t, []int{peekedValue, poppedValue}, ts.expectedValue)
+			}
+
+			if ts.expectedErr != nil {
+				if popErr == nil || popErr.Error() != ts.expectedErr.Error() {
+					test.ReportTestFailure(t, popErr, ts.expectedErr)
+				}
+				if peekErr == nil {
+					test.ReportTestFailure(t, peekErr, "an error peeking an empty deque")
+				}
+			} else if popErr != nil || peekErr != nil {
+				test.ReportTestFailure(t, popErr, ts.expectedErr)
+			}
+
+			if actualValues := dequeValues(t, ts.deque); !cmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+}
+
+func TestDeque_Get(t *testing.T) {
+	type scenario struct {
+		name          string
+		index         int
+		expectedValue int
+		expectedErr   error
+	}
+
+	// push onto the front so the values wrap around the end of the buffer
+	d := NewDeque[int]()
+	d.PushBack(3)
+	d.PushBack(4)
+	d.PushBack(5)
+	d.PushFront(2)
+	d.PushFront(1)
+
+	testScenarios := []scenario{
+		{name: "get front", index: 0, expectedValue: 1},
+		{name: "get wrapped around", index: 1, expectedValue: 2},
+		{name: "get back", index: 4, expectedValue: 5},
+		{name: "get negative index", index: -1, expectedErr: fmt.Errorf("index -1 out of bounds for deque of length 5")},
+		{name: "get past the back", index: 5, expectedErr: fmt.Errorf("index 5 out of bounds for deque of length 5")},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualValue, err := d.Get(ts.index)
+
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if ts.expectedErr != nil && (err == nil || err.Error() != ts.expectedErr.Error()) ||
+				ts.expectedErr == nil && err != nil {
+				test.ReportTestFailure(t, err, ts.expectedErr)
+			}
+		})
+	}
+}
+
+func TestDeque_Resize(t *testing.T) {
+	d := NewDeque[int]()
+
+	// alternate ends so the front wraps below zero while the buffer grows and then shrinks
+	expectedValues := make([]int, 0)
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.PushFront(i)
+			expectedValues = append([]int{i}, expectedValues...)
+		} else {
+			d.PushBack(i)
+			expectedValues = append(expectedValues, i)
+		}
+	}
+
+	if actualValues := dequeValues(t, d); !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	for i := 0; i < 92; i++ {
+		if i%2 == 0 {
+			d.PopFront()
+			expectedValues = expectedValues[1:]
+		} else {
+			d.PopBack()
+			expectedValues = expectedValues[:len(expectedValues)-1]
+		}
+	}
+
+	if actualValues := dequeValues(t, d); !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	if len(d.buffer) != minRingQueueSize {
+		test.ReportTestFailure(t, len(d.buffer), minRingQueueSize)
+	}
+}
+
+func TestDeque_Queue(t *testing.T) {
+	var q Queue[int] = NewDeque[int]()
+	for i := 1; i <= 3; i++ {
+		q.Push(i)
+	}
+
+	// as a Queue the deque is first in, first out
+	expectedValues := []int{1, 2, 3}
+	if actualValues := drainQueue(q); !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+}
+
+func TestDeque_PopClearsSlot(t *testing.T) {
+	d := NewDeque[*int]()
+	value := 1
+	d.PushBack(&value)
+	d.PushBack(&value)
+
+	d.PopFront()
+	d.PopBack()
+
+	for i, slot := range d.buffer {
+		if slot != nil {
+			test.ReportTestFailure(t, fmt.Sprintf("slot %d holds %v", i, slot), nil)
+		}
+	}
+}
+
+func TestDeque_AllBackward(t *testing.T) {
+	// push onto the front so the values wrap around the end of the buffer
+	d := NewDeque[int]()
+	d.PushBack(3)
+	d.PushBack(4)
+	d.PushBack(5)
+	d.PushFront(2)
+	d.PushFront(1)
+
+	actualValues := make([]int, 0)
+	for value := range d.All() {
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues := []int{1, 2, 3, 4, 5}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	actualValues = actualValues[:0]
+	for value := range d.Backward() {
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues = []int{5, 4, 3, 2, 1}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	// an empty struct has nothing to visit
+	for value := range (&Deque[int]{}).Backward() {
+		test.ReportTestFailure(t, value, nil)
+	}
+}
@@ -1,85 +1,267 @@
 package queue
 
 import (
+	"cmp"
 	"fmt"
+	"github.com/devsquared/gods/collection"
 	heap2 "github.com/devsquared/gods/heap"
-	"reflect"
+	"iter"
+	"slices"
+)
+
+// ensure PriorityQueue satisfies the Queue and Iterable interfaces at compile time
+var (
+	_ Queue[any]               = (*PriorityQueue[any, int])(nil)
+	_ collection.Iterable[any] = (*PriorityQueue[any, int])(nil)
 )
 
 // PQItem represents a priority queue item with a value and a priority.
 // A higher priority item gets popped sooner than a lower priority item.
-type PQItem struct {
-	value    any
-	priority int
+type PQItem[T any, P cmp.Ordered] struct {
+	Value    T
+	Priority P
+
+	seq uint64 // insertion order used to break ties between equal priorities in a stable queue
+}
+
+// PriorityQueueOption configures optional behaviour of a PriorityQueue when it is constructed.
+type PriorityQueueOption func(*priorityQueueOptions)
+
+type priorityQueueOptions struct {
+	stable bool
+}
+
+// WithStableOrder makes items of equal priority pop in the order they were pushed (first in, first out). Without it,
+// the order among equal priorities is whatever the heap happens to produce.
+func WithStableOrder() PriorityQueueOption {
+	return func(options *priorityQueueOptions) {
+		options.stable = true
+	}
+}
+
+// NewPQItem creates a simple item structure for use in a priority queue.
+func NewPQItem[T any, P cmp.Ordered](value T, priority P) PQItem[T, P] {
+	return PQItem[T, P]{
+		Value:    value,
+		Priority: priority,
+	}
 }
 
 // PriorityQueue represents a queue in which the elements of the queue are sorted to be popped based on priority.
-// The higher the queue, the sooner it pops from the queue. Due to utilizing a slice-based max heap for implementation,
-// resizing and sorting is done as items are added or popped from the queue.
-type PriorityQueue struct {
-	Heap  *heap2.MaxHeap
-	Count int
+// The higher the priority, the sooner it pops from the queue. Priorities may be of any ordered type, such as ints,
+// floats or strings. Due to utilizing a slice-based heap for implementation, resizing and sorting is done as items
+// are added or popped from the queue. Any other heap implementation can back the queue through
+// NewPriorityQueueWithHeap. Items of equal priority pop in no particular order unless the queue is created with
+// WithStableOrder.
+type PriorityQueue[T any, P cmp.Ordered] struct {
+	heap heap2.Heaper[PQItem[T, P]]
+
+	// bounded is the same heap as heap when the queue has a capacity; it lets the lowest priority item be evicted
+	bounded  *heap2.MinMaxHeap[PQItem[T, P]]
+	capacity int
+
+	stable  bool
+	nextSeq uint64
 }
 
 // NewPriorityQueue is a simple constructor that creates an empty priority queue.
-func NewPriorityQueue() *PriorityQueue {
-	return &PriorityQueue{
-		Heap:  heap2.NewMaxHeap(),
-		Count: 0,
+func NewPriorityQueue[T any, P cmp.Ordered](options ...PriorityQueueOption) *PriorityQueue[T, P] {
+	q := &PriorityQueue[T, P]{
+		heap: heap2.New(higherPriority[T, P]),
+	}
+	q.apply(options)
+
+	return q
+}
+
+// NewPriorityQueueWithHeap creates an empty priority queue backed by the heap returned from newHeap, such as a pairing
+// or leftist heap. newHeap is given the ordering the heap must follow to keep the highest priority item on top.
+func NewPriorityQueueWithHeap[T any, P cmp.Ordered](
+	newHeap func(less func(a, b PQItem[T, P]) bool) heap2.Heaper[PQItem[T, P]],
+	options ...PriorityQueueOption,
+) *PriorityQueue[T, P] {
+	q := &PriorityQueue[T, P]{
+		heap: newHeap(higherPriority[T, P]),
+	}
+	q.apply(options)
+
+	return q
+}
+
+// NewBoundedPriorityQueue creates an empty priority queue that holds at most capacity items. Once the queue is full,
+// pushing an item evicts the lowest priority item to make room for it. The queue is backed by a min-max heap so that
+// both the highest and the lowest priority items can be reached in O(log n). A capacity below 1 panics.
+func NewBoundedPriorityQueue[T any, P cmp.Ordered](capacity int, options ...PriorityQueueOption) *PriorityQueue[T, P] {
+	if capacity < 1 {
+		panic("priority queue: capacity must be at least 1")
+	}
+
+	bounded := heap2.NewMinMax(higherPriority[T, P])
+
+	q := &PriorityQueue[T, P]{
+		heap:     bounded,
+		bounded:  bounded,
+		capacity: capacity,
+	}
+	q.apply(options)
+
+	return q
+}
+
+// apply sets up the queue according to the given options.
+func (q *PriorityQueue[T, P]) apply(options []PriorityQueueOption) {
+	var applied priorityQueueOptions
+	for _, option := range options {
+		option(&applied)
 	}
+
+	q.stable = applied.stable
+}
+
+// Pop removes the item with the highest priority from the queue and returns its value.
+func (q *PriorityQueue[T, P]) Pop() (T, error) {
+	item, err := q.PopItem()
+	return item.Value, err
 }
 
-// Pop removes the item with the highest priority from the queue.
-func (q *PriorityQueue) Pop() (any, error) {
+// PopItem removes the item with the highest priority from the queue and returns it along with its priority.
+func (q *PriorityQueue[T, P]) PopItem() (PQItem[T, P], error) {
 	// return error if the queue is empty
-	if q.Count <= 0 {
-		return nil, fmt.Errorf("priority queue: pop called on empty queue")
+	if q.Length() <= 0 {
+		return PQItem[T, P]{}, fmt.Errorf("priority queue: pop called on empty queue")
 	}
 
-	poppedValue, err := q.Heap.Pop()
+	item, err := q.heap.Pop()
 	if err != nil {
-		return nil, fmt.Errorf("priority queue: error in pop: %e", err)
+		return PQItem[T, P]{}, fmt.Errorf("priority queue: error in pop: %w", err)
 	}
 
-	q.Count--
-	return poppedValue, nil
+	item.seq = 0 // the insertion order is internal to the queue
+	return item, nil
+}
+
+// Push enqueues an element onto the PriorityQueue with the zero value of P as its priority.
+// Use PushItem to enqueue an element with a given priority.
+func (q *PriorityQueue[T, P]) Push(element T) {
+	var priority P
+	q.PushItem(NewPQItem(element, priority))
 }
 
-// Push enqueues an element onto the PriorityQueue. If an element is given that is not a PQItem, a priority of 0 is given.
-func (q *PriorityQueue) Push(element any) {
+// PushItem enqueues an item onto the PriorityQueue according to its priority. If the queue is bounded and full, the
+// lowest priority item is evicted to make room, unless the new item has no higher a priority than it, in which case
+// the new item is the one dropped.
+func (q *PriorityQueue[T, P]) PushItem(item PQItem[T, P]) {
 	// in the case that an empty struct was used, let's initialize the underlying heap
-	if q.Heap == nil {
-		q.Heap = heap2.NewMaxHeap()
+	if q.heap == nil {
+		q.heap = heap2.New(higherPriority[T, P])
+	}
+
+	if q.stable {
+		item.seq = q.nextSeq
+		q.nextSeq++
 	}
 
-	var item PQItem
-	if reflect.ValueOf(element).Kind() != reflect.ValueOf(PQItem{}).Kind() {
-		item = PQItem{value: element, priority: 0}
-	} else {
-		item = element.(PQItem)
+	if q.bounded != nil && q.bounded.Length() >= q.capacity {
+		lowest, _ := q.bounded.PeekMax()
+		if !higherPriority(item, lowest) {
+			return
+		}
+
+		_, _ = q.bounded.PopMax()
 	}
 
-	newHeapNode := heap2.NewNode(item.priority, item.value)
-	q.Heap.Add(newHeapNode)
-	q.Count++
+	q.heap.Add(item)
 }
 
 // Peek returns the value of the item with the highest priority in the queue. This, however, does not remove the item.
-func (q *PriorityQueue) Peek() (any, error) {
+func (q *PriorityQueue[T, P]) Peek() (T, error) {
+	item, err := q.PeekItem()
+	return item.Value, err
+}
+
+// PeekItem returns the item with the highest priority in the queue without removing it.
+func (q *PriorityQueue[T, P]) PeekItem() (PQItem[T, P], error) {
 	// return error if the queue is empty
-	if q.Count <= 0 {
-		return nil, fmt.Errorf("priority queue: peek called on empty queue")
+	if q.Length() <= 0 {
+		return PQItem[T, P]{}, fmt.Errorf("priority queue: peek called on empty queue")
 	}
 
-	peekValue, err := q.Heap.GetFirstValue()
+	item, err := q.heap.GetFirstValue()
 	if err != nil {
-		return nil, fmt.Errorf("priority queue: error in peek: %e", err)
+		return PQItem[T, P]{}, fmt.Errorf("priority queue: error in peek: %w", err)
 	}
 
-	return peekValue, nil
+	item.seq = 0 // the insertion order is internal to the queue
+	return item, nil
 }
 
 // Length gives the length of the priority queue.
-func (q *PriorityQueue) Length() int {
-	return q.Count
+func (q *PriorityQueue[T, P]) Length() int {
+	if q.heap == nil {
+		return 0
+	}
+
+	return q.heap.Length()
+}
+
+// Capacity gives the most items a bounded priority queue holds, or 0 when the queue is unbounded.
+func (q *PriorityQueue[T, P]) Capacity() int {
+	return q.capacity
+}
+
+// All returns an iterator over the values of the queue from the highest priority to the lowest, which is the order
+// they would be popped in. The queue is left untouched: its items are copied and sorted in O(n log n) when iteration
+// starts, so the queue may be changed while iterating over it without affecting the values visited.
+func (q *PriorityQueue[T, P]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.sortedItems() {
+			if !yield(item.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Items returns an iterator over the items of the queue, along with their priorities, in the same order as All.
+func (q *PriorityQueue[T, P]) Items() iter.Seq[PQItem[T, P]] {
+	return func(yield func(PQItem[T, P]) bool) {
+		for _, item := range q.sortedItems() {
+			item.seq = 0 // the insertion order is internal to the queue
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// sortedItems copies the items of the queue into a slice in the order they would be popped in.
+func (q *PriorityQueue[T, P]) sortedItems() []PQItem[T, P] {
+	if q.heap == nil {
+		return nil
+	}
+
+	items := slices.Collect(q.heap.All())
+	slices.SortFunc(items, func(a, b PQItem[T, P]) int {
+		switch {
+		case higherPriority(a, b):
+			return -1
+		case higherPriority(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return items
+}
+
+// higherPriority orders items so that the highest priority item sits at the top of the heap. Equal priorities fall
+// back to insertion order, which only differs between items when the queue is stable. Priorities are compared with
+// cmp.Compare so that a NaN float priority still orders consistently, as lower than every other priority.
+func higherPriority[T any, P cmp.Ordered](a, b PQItem[T, P]) bool {
+	if c := cmp.Compare(a.Priority, b.Priority); c != 0 {
+		return c > 0
+	}
+
+	return a.seq < b.seq
 }
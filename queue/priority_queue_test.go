@@ -5,27 +5,43 @@ import (
 	"github.com/devsquared/gods/heap"
 	"github.com/devsquared/gods/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"math"
+	"slices"
+	"strconv"
 	"testing"
+	"time"
 )
 
+// drainQueue pops every value off the queue in order.
+func drainQueue[T any](q Queue[T]) []T {
+	values := make([]T, 0, q.Length())
+	for q.Length() > 0 {
+		value, _ := q.Pop()
+		values = append(values, value)
+	}
+
+	return values
+}
+
 func TestPriorityQueue_Length(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *PriorityQueue
+		queue          *PriorityQueue[string, int]
 		expectedLength int
 	}
 
-	pQueueWithSingleItem := NewPriorityQueue()
-	pQueueWithSingleItem.Push(PQItem{value: "hi", priority: 0})
+	pQueueWithSingleItem := NewPriorityQueue[string, int]()
+	pQueueWithSingleItem.PushItem(NewPQItem("hi", 0))
 
-	pQueueWithMultipleItems := NewPriorityQueue()
-	pQueueWithMultipleItems.Push(PQItem{value: "hello", priority: 1})
-	pQueueWithMultipleItems.Push(PQItem{value: "hiya", priority: 2})
+	pQueueWithMultipleItems := NewPriorityQueue[string, int]()
+	pQueueWithMultipleItems.PushItem(NewPQItem("hello", 1))
+	pQueueWithMultipleItems.PushItem(NewPQItem("hiya", 2))
 
 	testScenarios := []scenario{
 		{
 			name:           "length of empty queue",
-			queue:          NewPriorityQueue(),
+			queue:          NewPriorityQueue[string, int](),
 			expectedLength: 0,
 		},
 		{
@@ -54,28 +70,26 @@ func TestPriorityQueue_Length(t *testing.T) {
 func TestPriorityQueue_Peek(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *PriorityQueue
-		expectedValue  any
+		queue          *PriorityQueue[string, int]
+		expectedValue  string
 		expectedErr    error
 		expectedLength int
-		copiedQueue    *PriorityQueue
 	}
 
-	pQueueWithSingleItem := NewPriorityQueue()
-	pQueueWithSingleItem.Push(PQItem{value: "hi", priority: 0})
+	pQueueWithSingleItem := NewPriorityQueue[string, int]()
+	pQueueWithSingleItem.PushItem(NewPQItem("hi", 0))
 
-	pQueueWithMultipleItems := NewPriorityQueue()
-	pQueueWithMultipleItems.Push(PQItem{value: "hello", priority: 1})
-	pQueueWithMultipleItems.Push(PQItem{value: "hiya", priority: 2})
+	pQueueWithMultipleItems := NewPriorityQueue[string, int]()
+	pQueueWithMultipleItems.PushItem(NewPQItem("hello", 1))
+	pQueueWithMultipleItems.PushItem(NewPQItem("hiya", 2))
 
 	testScenarios := []scenario{
 		{
 			name:           "peek on empty queue",
-			queue:          NewPriorityQueue(),
+			queue:          NewPriorityQueue[string, int](),
 			expectedErr:    fmt.Errorf("priority queue: peek called on empty queue"),
-			expectedValue:  nil,
+			expectedValue:  "",
 			expectedLength: 0,
-			copiedQueue:    NewPriorityQueue(),
 		},
 		{
 			name:           "peek on queue with single item",
@@ -83,7 +97,6 @@ func TestPriorityQueue_Peek(t *testing.T) {
 			expectedErr:    nil,
 			expectedValue:  "hi",
 			expectedLength: 1,
-			copiedQueue:    pQueueWithSingleItem,
 		},
 		{
 			name:           "peek on queue with multiple items",
@@ -91,12 +104,12 @@ func TestPriorityQueue_Peek(t *testing.T) {
 			expectedErr:    nil,
 			expectedValue:  "hiya",
 			expectedLength: 2,
-			copiedQueue:    pQueueWithMultipleItems,
 		},
 	}
 
 	for _, ts := range testScenarios {
 		t.Run(ts.name, func(t *testing.T) {
+			itemsBefore := slices.Collect(ts.queue.Items())
 			actualValue, actualErr := ts.queue.Peek()
 
 			if actualValue != ts.expectedValue {
@@ -112,8 +125,13 @@ func TestPriorityQueue_Peek(t *testing.T) {
 			}
 
 			// make sure that the queue is unchanged by a peek
-			if !cmp.Equal(ts.queue, ts.copiedQueue) {
-				test.ReportTestFailure(t, ts.queue, ts.copiedQueue)
+			itemsAfter := slices.Collect(ts.queue.Items())
+			if !cmp.Equal(itemsAfter, itemsBefore, cmp.AllowUnexported(PQItem[string, int]{})) {
+				test.ReportTestFailure(t, itemsAfter, itemsBefore)
+			}
+
+			if poppedValue, _ := ts.queue.Pop(); poppedValue != ts.expectedValue {
+				test.ReportTestFailure(t, poppedValue, ts.expectedValue)
 			}
 		})
 	}
@@ -121,50 +139,45 @@ func TestPriorityQueue_Peek(t *testing.T) {
 
 func TestPriorityQueue_Pop(t *testing.T) {
 	type scenario struct {
-		name           string
-		queue          *PriorityQueue
-		expectedValue  any
-		expectedErr    error
-		expectedLength int
-		underlyingHeap *heap.MaxHeap
+		name            string
+		queue           *PriorityQueue[string, int]
+		expectedValue   string
+		expectedErr     error
+		expectedLength  int
+		expectedDrained []string
 	}
 
-	pQueueWithSingleItem := NewPriorityQueue()
-	pQueueWithSingleItem.Push(PQItem{value: "hi", priority: 0})
-
-	pQueueWithMultipleItems := NewPriorityQueue()
-	pQueueWithMultipleItems.Push(PQItem{value: "hello", priority: 1})
-	pQueueWithMultipleItems.Push(PQItem{value: "hiya", priority: 2})
+	pQueueWithSingleItem := NewPriorityQueue[string, int]()
+	pQueueWithSingleItem.PushItem(NewPQItem("hi", 0))
 
-	heapFromPQueueWithSingleItemAfterPop := heap.NewMaxHeap() // becomes empty; left for read- and reason-ability
-
-	heapFromPQueueWithMultipleItemsAfterPop := heap.NewMaxHeap()
-	heapFromPQueueWithMultipleItemsAfterPop.Add(heap.NewNode(1, "hello")) // heap left with one node after pop
+	pQueueWithMultipleItems := NewPriorityQueue[string, int]()
+	pQueueWithMultipleItems.PushItem(NewPQItem("hello", 1))
+	pQueueWithMultipleItems.PushItem(NewPQItem("hiya", 2))
 
 	testScenarios := []scenario{
 		{
-			name:           "pop on an empty queue",
-			queue:          NewPriorityQueue(),
-			expectedValue:  nil,
-			expectedErr:    fmt.Errorf("priority queue: pop called on empty queue"),
-			expectedLength: 0,
-			underlyingHeap: heap.NewMaxHeap(), // empty heap
+			name:            "pop on an empty queue",
+			queue:           NewPriorityQueue[string, int](),
+			expectedValue:   "",
+			expectedErr:     fmt.Errorf("priority queue: pop called on empty queue"),
+			expectedLength:  0,
+			expectedDrained: []string{},
 		},
 		{
-			name:           "pop on queue with single item",
-			queue:          pQueueWithSingleItem,
-			expectedValue:  "hi",
-			expectedErr:    nil,
-			expectedLength: 0,
-			underlyingHeap: heapFromPQueueWithSingleItemAfterPop, // becomes empty after pop
+			name:            "pop on queue with single item",
+			queue:           pQueueWithSingleItem,
+			expectedValue:   "hi",
+			expectedErr:     nil,
+			expectedLength:  0,
+			expectedDrained: []string{}, // becomes empty after pop
 		},
 		{
-			name:           "pop on queue with multiple items",
-			queue:          pQueueWithMultipleItems,
-			expectedValue:  "hiya",
-			expectedErr:    nil,
-			expectedLength: 1,
-			underlyingHeap: heapFromPQueueWithMultipleItemsAfterPop,
+			name:            "pop on queue with multiple items",
+			queue:           pQueueWithMultipleItems,
+			expectedValue:   "hiya",
+			expectedErr:     nil,
+			expectedLength:  1,
+			expectedDrained: []string{"hello"},
 		},
 	}
 
@@ -184,78 +197,440 @@ func TestPriorityQueue_Pop(t *testing.T) {
 				test.ReportTestFailure(t, ts.queue.Length(), ts.expectedLength)
 			}
 
+			// make sure that the remaining items are as expected after pop
+			actualDrained := drainQueue[string](ts.queue)
+			if !cmp.Equal(actualDrained, ts.expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, ts.expectedDrained)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Push(t *testing.T) {
+	type scenario struct {
+		name            string
+		queue           *PriorityQueue[string, int]
+		input           string
+		expectedLength  int
+		expectedDrained []string
+	}
+
+	pQueueWithSingleItem := NewPriorityQueue[string, int]()
+	pQueueWithSingleItem.PushItem(NewPQItem("hello", 1))
+
+	pQueueWithNegativeItem := NewPriorityQueue[string, int]()
+	pQueueWithNegativeItem.PushItem(NewPQItem("hello", -1))
+
+	testScenarios := []scenario{
+		{
+			name:            "push on empty struct",
+			queue:           &PriorityQueue[string, int]{}, // create empty struct without constructor
+			input:           "hello",
+			expectedLength:  1,
+			expectedDrained: []string{"hello"},
+		},
+		{
+			name:            "push queues at zero priority below higher priorities",
+			queue:           pQueueWithSingleItem,
+			input:           "item",
+			expectedLength:  2,
+			expectedDrained: []string{"hello", "item"},
+		},
+		{
+			name:            "push queues at zero priority above lower priorities",
+			queue:           pQueueWithNegativeItem,
+			input:           "item",
+			expectedLength:  2,
+			expectedDrained: []string{"item", "hello"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			ts.queue.Push(ts.input)
+
 			if ts.queue.Length() != ts.expectedLength {
 				test.ReportTestFailure(t, ts.queue.Length(), ts.expectedLength)
 			}
 
-			// make sure that the underlying is as expected after pop
-			if !cmp.Equal(ts.queue.Heap, ts.underlyingHeap) {
-				test.ReportTestFailure(t, ts.queue.Heap, ts.underlyingHeap)
+			actualDrained := drainQueue[string](ts.queue)
+			if !cmp.Equal(actualDrained, ts.expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, ts.expectedDrained)
 			}
 		})
 	}
 }
 
-func TestPriorityQueue_Push(t *testing.T) {
+func TestPriorityQueue_PushItem(t *testing.T) {
 	type scenario struct {
-		name           string
-		queue          *PriorityQueue
-		input          any
-		expectedQueue  *PriorityQueue
-		expectedLength int
+		name            string
+		input           []PQItem[string, int]
+		expectedDrained []string
 	}
 
-	pQueueWithSingleItem := NewPriorityQueue()
-	pQueueWithSingleItem.Push(PQItem{value: "hello", priority: 0})
+	testScenarios := []scenario{
+		{
+			name:            "push in ascending priority",
+			input:           []PQItem[string, int]{NewPQItem("a", 1), NewPQItem("b", 2), NewPQItem("c", 3)},
+			expectedDrained: []string{"c", "b", "a"},
+		},
+		{
+			name:            "push in descending priority",
+			input:           []PQItem[string, int]{NewPQItem("c", 3), NewPQItem("b", 2), NewPQItem("a", 1)},
+			expectedDrained: []string{"c", "b", "a"},
+		},
+		{
+			name: "push in mixed priority",
+			input: []PQItem[string, int]{
+				NewPQItem("b", 2), NewPQItem("e", -10), NewPQItem("a", 50), NewPQItem("d", 0), NewPQItem("c", 1),
+			},
+			expectedDrained: []string{"a", "b", "c", "d", "e"},
+		},
+	}
 
-	pQueueWithMultipleItems := NewPriorityQueue()
-	pQueueWithMultipleItems.Push(PQItem{value: "hello", priority: 0})
-	pQueueWithMultipleItems.Push(PQItem{value: "hiya", priority: 2})
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			queue := NewPriorityQueue[string, int]()
+			for _, item := range ts.input {
+				queue.PushItem(item)
+			}
 
-	pQueueWithMultipleAgain := NewPriorityQueue()
-	pQueueWithMultipleAgain.Push(PQItem{value: "hello", priority: 0})
-	pQueueWithMultipleAgain.Push(PQItem{value: "hiya", priority: 2})
+			actualDrained := drainQueue[string](queue)
+			if !cmp.Equal(actualDrained, ts.expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, ts.expectedDrained)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_OrderedPriorities(t *testing.T) {
+	// priorities may be of any ordered type
+	now := time.Now()
+
+	floatQueue := NewPriorityQueue[string, float64]()
+	floatQueue.PushItem(NewPQItem("low", 0.1))
+	floatQueue.PushItem(NewPQItem("high", 0.9))
+	floatQueue.PushItem(NewPQItem("middle", 0.5))
+
+	stringQueue := NewPriorityQueue[string, string]()
+	stringQueue.PushItem(NewPQItem("low", "apple"))
+	stringQueue.PushItem(NewPQItem("high", "cherry"))
+	stringQueue.PushItem(NewPQItem("middle", "banana"))
+
+	timestampQueue := NewPriorityQueue[string, int64]()
+	timestampQueue.PushItem(NewPQItem("low", now.UnixNano()))
+	timestampQueue.PushItem(NewPQItem("high", now.Add(time.Hour).UnixNano()))
+	timestampQueue.PushItem(NewPQItem("middle", now.Add(time.Minute).UnixNano()))
+
+	expectedDrained := []string{"high", "middle", "low"}
+
+	t.Run("NaN priorities", func(t *testing.T) {
+		// NaN sorts below every other priority, as cmp.Compare has it
+		nanQueue := NewPriorityQueue[string, float64]()
+		for i, priority := range []float64{0.3, math.NaN(), 0.9, 0.1, math.NaN(), 0.5, 0.7} {
+			nanQueue.PushItem(NewPQItem(strconv.Itoa(i), priority))
+		}
+
+		actualPriorities := make([]float64, 0)
+		for nanQueue.Length() > 0 {
+			item, _ := nanQueue.PopItem()
+			actualPriorities = append(actualPriorities, item.Priority)
+		}
+
+		expectedPriorities := []float64{0.9, 0.7, 0.5, 0.3, 0.1, math.NaN(), math.NaN()}
+		if !cmp.Equal(actualPriorities, expectedPriorities, cmpopts.EquateNaNs()) {
+			test.ReportTestFailure(t, actualPriorities, expectedPriorities)
+		}
+	})
+
+	for name, actualDrained := range map[string][]string{
+		"float priorities":     drainQueue[string](floatQueue),
+		"string priorities":    drainQueue[string](stringQueue),
+		"timestamp priorities": drainQueue[string](timestampQueue),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if !cmp.Equal(actualDrained, expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, expectedDrained)
+			}
+		})
+	}
+}
 
-	pQueueWithMultipleItemsWithPushedZeroPrio := pQueueWithMultipleItems
-	pQueueWithMultipleItemsWithPushedZeroPrio.Push("item")
+func TestPriorityQueue_PopItem(t *testing.T) {
+	queue := NewPriorityQueue[string, int]()
+	queue.PushItem(NewPQItem("hello", 1))
+	queue.PushItem(NewPQItem("hiya", 2))
+
+	expectedItem := NewPQItem("hiya", 2)
+
+	peekedItem, err := queue.PeekItem()
+	if err != nil || peekedItem != expectedItem {
+		test.ReportTestFailure(t, peekedItem, expectedItem)
+	}
+
+	poppedItem, err := queue.PopItem()
+	if err != nil || poppedItem != expectedItem {
+		test.ReportTestFailure(t, poppedItem, expectedItem)
+	}
+}
+
+func TestNewPriorityQueueWithHeap(t *testing.T) {
+	type scenario struct {
+		name  string
+		queue *PriorityQueue[string, int]
+	}
 
 	testScenarios := []scenario{
 		{
-			name:           "push on empty struct",
-			queue:          &PriorityQueue{}, // create empty struct without constructor
-			input:          "hello",
-			expectedQueue:  pQueueWithSingleItem,
-			expectedLength: 1,
+			name: "backed by binary heap",
+			queue: NewPriorityQueueWithHeap(func(less func(a, b PQItem[string, int]) bool) heap.Heaper[PQItem[string, int]] {
+				return heap.New(less)
+			}),
 		},
 		{
-			// For this test, it is important to also test the casting to a PQItem in the case that wasn't given.
-			name:           "push non-PQItem to queue for casting and queueing at 0 priority",
-			queue:          pQueueWithMultipleItems,
-			input:          "item",
-			expectedQueue:  pQueueWithMultipleItemsWithPushedZeroPrio,
-			expectedLength: 4,
+			name: "backed by pairing heap",
+			queue: NewPriorityQueueWithHeap(func(less func(a, b PQItem[string, int]) bool) heap.Heaper[PQItem[string, int]] {
+				return heap.NewPairing(less)
+			}),
 		},
 		{
-			name:           "push on queue with current items",
-			queue:          pQueueWithSingleItem,
-			input:          PQItem{value: "hiya", priority: 2},
-			expectedQueue:  pQueueWithMultipleAgain,
-			expectedLength: 2,
+			name: "backed by leftist heap",
+			queue: NewPriorityQueueWithHeap(func(less func(a, b PQItem[string, int]) bool) heap.Heaper[PQItem[string, int]] {
+				return heap.NewLeftist(less)
+			}),
 		},
 	}
 
+	expectedDrained := []string{"a", "b", "c", "d"}
+
 	for _, ts := range testScenarios {
 		t.Run(ts.name, func(t *testing.T) {
-			ts.queue.Push(ts.input)
+			ts.queue.PushItem(NewPQItem("c", 2))
+			ts.queue.PushItem(NewPQItem("a", 9))
+			ts.queue.Push("d")
+			ts.queue.PushItem(NewPQItem("b", 5))
+
+			actualDrained := drainQueue[string](ts.queue)
+			if !cmp.Equal(actualDrained, expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, expectedDrained)
+			}
+		})
+	}
+}
 
-			if ts.queue.Length() != ts.expectedLength {
-				test.ReportTestFailure(t, ts.queue.Length(), ts.expectedLength)
+func TestNewBoundedPriorityQueuePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected NewBoundedPriorityQueue to panic with capacity below 1")
+		}
+	}()
+
+	NewBoundedPriorityQueue[string, int](0)
+}
+
+func TestBoundedPriorityQueue_PushItem(t *testing.T) {
+	type scenario struct {
+		name            string
+		capacity        int
+		input           []PQItem[string, int]
+		expectedLength  int
+		expectedDrained []string
+	}
+
+	testScenarios := []scenario{
+		{
+			name:            "under capacity keeps everything",
+			capacity:        5,
+			input:           []PQItem[string, int]{NewPQItem("b", 2), NewPQItem("a", 3), NewPQItem("c", 1)},
+			expectedLength:  3,
+			expectedDrained: []string{"a", "b", "c"},
+		},
+		{
+			name:     "over capacity evicts lowest priority",
+			capacity: 3,
+			input: []PQItem[string, int]{
+				NewPQItem("c", 3), NewPQItem("e", 1), NewPQItem("a", 5), NewPQItem("d", 2), NewPQItem("b", 4),
+			},
+			expectedLength:  3,
+			expectedDrained: []string{"a", "b", "c"},
+		},
+		{
+			name:     "lowest priority push is dropped when full",
+			capacity: 2,
+			input: []PQItem[string, int]{
+				NewPQItem("a", 5), NewPQItem("b", 4), NewPQItem("dropped", 1), NewPQItem("tied", 4),
+			},
+			expectedLength:  2,
+			expectedDrained: []string{"a", "b"},
+		},
+		{
+			name:            "capacity of one keeps the highest priority",
+			capacity:        1,
+			input:           []PQItem[string, int]{NewPQItem("b", 1), NewPQItem("a", 7), NewPQItem("c", 3)},
+			expectedLength:  1,
+			expectedDrained: []string{"a"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			queue := NewBoundedPriorityQueue[string, int](ts.capacity)
+			for _, item := range ts.input {
+				queue.PushItem(item)
 			}
 
-			// make sure that the underlying is as expected after pop
-			if !cmp.Equal(ts.queue, ts.expectedQueue) {
-				test.ReportTestFailure(t, ts.queue, ts.expectedQueue)
+			if queue.Capacity() != ts.capacity {
+				test.ReportTestFailure(t, queue.Capacity(), ts.capacity)
+			}
+
+			if queue.Length() != ts.expectedLength {
+				test.ReportTestFailure(t, queue.Length(), ts.expectedLength)
+			}
+
+			actualDrained := drainQueue[string](queue)
+			if !cmp.Equal(actualDrained, ts.expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, ts.expectedDrained)
 			}
 		})
 	}
 }
+
+func TestPriorityQueue_StableOrder(t *testing.T) {
+	type scenario struct {
+		name            string
+		queue           *PriorityQueue[string, int]
+		input           []PQItem[string, int]
+		expectedDrained []string
+	}
+
+	input := []PQItem[string, int]{
+		NewPQItem("low-1", 1), NewPQItem("high-1", 5), NewPQItem("low-2", 1), NewPQItem("mid-1", 3),
+		NewPQItem("high-2", 5), NewPQItem("low-3", 1), NewPQItem("mid-2", 3), NewPQItem("high-3", 5),
+		NewPQItem("low-4", 1), NewPQItem("high-4", 5),
+	}
+	expectedDrained := []string{
+		"high-1", "high-2", "high-3", "high-4", "mid-1", "mid-2", "low-1", "low-2", "low-3", "low-4",
+	}
+
+	testScenarios := []scenario{
+		{
+			name:            "stable binary heap queue",
+			queue:           NewPriorityQueue[string, int](WithStableOrder()),
+			input:           input,
+			expectedDrained: expectedDrained,
+		},
+		{
+			name: "stable pairing heap queue",
+			queue: NewPriorityQueueWithHeap(func(less func(a, b PQItem[string, int]) bool) heap.Heaper[PQItem[string, int]] {
+				return heap.NewPairing(less)
+			}, WithStableOrder()),
+			input:           input,
+			expectedDrained: expectedDrained,
+		},
+		{
+			name:            "stable bounded queue drops the latest of the lowest priority",
+			queue:           NewBoundedPriorityQueue[string, int](8, WithStableOrder()),
+			input:           input,
+			expectedDrained: []string{"high-1", "high-2", "high-3", "high-4", "mid-1", "mid-2", "low-1", "low-2"},
+		},
+		{
+			name:            "stable order with all equal priorities is first in first out",
+			queue:           NewPriorityQueue[string, int](WithStableOrder()),
+			input:           []PQItem[string, int]{NewPQItem("a", 0), NewPQItem("b", 0), NewPQItem("c", 0), NewPQItem("d", 0)},
+			expectedDrained: []string{"a", "b", "c", "d"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			for _, item := range ts.input {
+				ts.queue.PushItem(item)
+			}
+
+			actualDrained := drainQueue[string](ts.queue)
+			if !cmp.Equal(actualDrained, ts.expectedDrained) {
+				test.ReportTestFailure(t, actualDrained, ts.expectedDrained)
+			}
+		})
+	}
+
+	t.Run("stable order survives interleaved pushes and pops", func(t *testing.T) {
+		queue := NewPriorityQueue[string, int](WithStableOrder())
+		queue.Push("first")
+		queue.Push("second")
+
+		popped, _ := queue.Pop()
+		if popped != "first" {
+			test.ReportTestFailure(t, popped, "first")
+		}
+
+		queue.Push("third")
+
+		expectedDrained := []string{"second", "third"}
+		actualDrained := drainQueue[string](queue)
+		if !cmp.Equal(actualDrained, expectedDrained) {
+			test.ReportTestFailure(t, actualDrained, expectedDrained)
+		}
+	})
+
+	t.Run("popped items compare equal to the pushed items", func(t *testing.T) {
+		queue := NewPriorityQueue[string, int](WithStableOrder())
+		queue.PushItem(NewPQItem("ignored", 1))
+		queue.PushItem(NewPQItem("item", 2))
+
+		expectedItem := NewPQItem("item", 2)
+		actualItem, _ := queue.PopItem()
+		if actualItem != expectedItem {
+			test.ReportTestFailure(t, actualItem, expectedItem)
+		}
+	})
+}
+
+func TestPriorityQueue_All(t *testing.T) {
+	q := NewPriorityQueue[string, int](WithStableOrder())
+	q.PushItem(NewPQItem("low", 1))
+	q.PushItem(NewPQItem("high", 3))
+	q.PushItem(NewPQItem("first middle", 2))
+	q.PushItem(NewPQItem("second middle", 2))
+
+	actualValues := make([]string, 0)
+	for value := range q.All() {
+		actualValues = append(actualValues, value)
+	}
+
+	// iterating visits the values in pop order without popping them
+	expectedValues := []string{"high", "first middle", "second middle", "low"}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	if q.Length() != 4 {
+		test.ReportTestFailure(t, q.Length(), 4)
+	}
+
+	actualItems := make([]PQItem[string, int], 0)
+	for item := range q.Items() {
+		if item.Priority < 2 {
+			break
+		}
+		actualItems = append(actualItems, item)
+	}
+
+	expectedItems := []PQItem[string, int]{
+		NewPQItem("high", 3),
+		NewPQItem("first middle", 2),
+		NewPQItem("second middle", 2),
+	}
+	if !cmp.Equal(actualItems, expectedItems, cmp.AllowUnexported(PQItem[string, int]{})) {
+		test.ReportTestFailure(t, actualItems, expectedItems)
+	}
+
+	if expectedValues = drainQueue[string](q); !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	// an empty struct has nothing to visit
+	for value := range (&PriorityQueue[string, int]{}).All() {
+		test.ReportTestFailure(t, value, nil)
+	}
+}
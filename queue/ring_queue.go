@@ -2,40 +2,48 @@ package queue
 
 import (
 	"fmt"
+	"github.com/devsquared/gods/collection"
+	"iter"
 )
 
-// Basic ring queue implementation with a basic slice of interface{}.
+// Basic ring queue implementation with a basic slice of T.
 // With this implementation of a ring queue, we utilize bit-masking to speed up the processes.
 // This means that it is important to keep the size of the buffer a power of 2.
 
 // minRingQueueSize starts at 16 and must be a power of 2.
 const minRingQueueSize = 16
 
+// ensure RingQueue satisfies the Queue and Reversible interfaces at compile time
+var (
+	_ Queue[any]                 = (*RingQueue[any])(nil)
+	_ collection.Reversible[any] = (*RingQueue[any])(nil)
+)
+
 // RingQueue represents the ring buffer queue.
-type RingQueue struct {
-	Buffer []any
+type RingQueue[T any] struct {
+	Buffer []T
 	Head   int // marker of the head in the slice
 	Tail   int // marker of the tail in the slice
 	Count  int // length of the queues contents; NOT necessarily total length of queue's buffer
 }
 
 // NewRingQueue constructs a new RingQueue instance.
-func NewRingQueue() *RingQueue {
-	return &RingQueue{
-		Buffer: make([]any, minRingQueueSize), //create a buffer the minimum size to begin
+func NewRingQueue[T any]() *RingQueue[T] {
+	return &RingQueue[T]{
+		Buffer: make([]T, minRingQueueSize), //create a buffer the minimum size to begin
 	}
 }
 
 // Length gets the length of the queue currently.
-func (q *RingQueue) Length() int {
+func (q *RingQueue[T]) Length() int {
 	return q.Count
 }
 
 // resize handles resizing the queue whenever it is needed. This will either double its length if space is needed
 // or it will shrink the size if the queue is less than half full.
-func (q *RingQueue) resize() {
+func (q *RingQueue[T]) resize() {
 	//start by doubling the size
-	newBuffer := make([]any, q.Count<<1)
+	newBuffer := make([]T, q.Count<<1)
 
 	//now appropriately copy the contents
 	if q.Tail > q.Head {
@@ -52,15 +60,10 @@ func (q *RingQueue) resize() {
 }
 
 // Push enqueues a new element on to the end of the queue.
-func (q *RingQueue) Push(element any) {
-	// if the element is nil, we don't need to add that
-	if element == nil {
-		return
-	}
-
+func (q *RingQueue[T]) Push(element T) {
 	// if the Buffer is uninitialized, let's initialize it
 	if q.Buffer == nil {
-		q.Buffer = make([]any, minRingQueueSize)
+		q.Buffer = make([]T, minRingQueueSize)
 	}
 
 	// if we have run out of room, let's resize
@@ -74,22 +77,25 @@ func (q *RingQueue) Push(element any) {
 }
 
 // Peek provides utility to see the front of the queue. Returns an error whenever the queue is empty.
-func (q *RingQueue) Peek() (any, error) {
+func (q *RingQueue[T]) Peek() (T, error) {
 	// if the queue is empty, error
 	if q.Count <= 0 {
-		return nil, fmt.Errorf("peek attempted on empty queue")
+		var zero T
+		return zero, fmt.Errorf("peek attempted on empty queue")
 	}
 	return q.Buffer[q.Head], nil
 }
 
 // Pop dequeues the element from the front of the queue and returns it. If the queue is empty, an error is returned.
-func (q *RingQueue) Pop() (any, error) {
+func (q *RingQueue[T]) Pop() (T, error) {
+	var zero T
+
 	// if the queue is empty, error
 	if q.Count <= 0 {
-		return nil, fmt.Errorf("pop attempted on empty queue")
+		return zero, fmt.Errorf("pop attempted on empty queue")
 	}
 	result := q.Buffer[q.Head]                  // get the result
-	q.Buffer[q.Head] = nil                      // remove result from queue
+	q.Buffer[q.Head] = zero                     // clear result from queue so it can be garbage collected
 	q.Head = (q.Head + 1) & (len(q.Buffer) - 1) // bitwise modulus using AND
 	q.Count--
 
@@ -100,3 +106,27 @@ func (q *RingQueue) Pop() (any, error) {
 
 	return result, nil
 }
+
+// All returns an iterator over the elements of the queue from front to back, which is the order they would be popped
+// in. The queue must not be changed while iterating over it.
+func (q *RingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.Count; i++ {
+			if !yield(q.Buffer[(q.Head+i)&(len(q.Buffer)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the queue from back to front. The queue must not be changed while
+// iterating over it.
+func (q *RingQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := q.Count - 1; i >= 0; i-- {
+			if !yield(q.Buffer[(q.Head+i)&(len(q.Buffer)-1)]) {
+				return
+			}
+		}
+	}
+}
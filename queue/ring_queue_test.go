@@ -10,21 +10,21 @@ import (
 func TestRingQueue_Length(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *RingQueue
+		queue          *RingQueue[string]
 		expectedLength int
 	}
 
-	rQueueWithSingleItem := NewRingQueue()
+	rQueueWithSingleItem := NewRingQueue[string]()
 	rQueueWithSingleItem.Push("item")
 
-	rQueueWithMultipleItem := NewRingQueue()
+	rQueueWithMultipleItem := NewRingQueue[string]()
 	rQueueWithMultipleItem.Push("item1")
 	rQueueWithMultipleItem.Push("item2")
 
 	testScenarios := []scenario{
 		{
 			name:           "length of empty queue",
-			queue:          NewRingQueue(),
+			queue:          NewRingQueue[string](),
 			expectedLength: 0,
 		},
 		{
@@ -51,26 +51,26 @@ func TestRingQueue_Length(t *testing.T) {
 func TestRingQueue_Peek(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *RingQueue
-		copiedQueue    *RingQueue
-		expectedValue  any
+		queue          *RingQueue[string]
+		copiedQueue    *RingQueue[string]
+		expectedValue  string
 		expectedErr    error
 		expectedLength int
 	}
 
-	rQueueWithSingleItem := NewRingQueue()
+	rQueueWithSingleItem := NewRingQueue[string]()
 	rQueueWithSingleItem.Push("item")
 
-	rQueueWithMultipleItem := NewRingQueue()
+	rQueueWithMultipleItem := NewRingQueue[string]()
 	rQueueWithMultipleItem.Push("item1")
 	rQueueWithMultipleItem.Push("item2")
 
 	testScenarios := []scenario{
 		{
 			name:           "attempted peak on empty queue",
-			queue:          NewRingQueue(),
-			copiedQueue:    NewRingQueue(),
-			expectedValue:  nil,
+			queue:          NewRingQueue[string](),
+			copiedQueue:    NewRingQueue[string](),
+			expectedValue:  "",
 			expectedErr:    fmt.Errorf("peek attempted on empty queue"),
 			expectedLength: 0,
 		},
@@ -119,36 +119,36 @@ func TestRingQueue_Peek(t *testing.T) {
 func TestRingQueue_Pop(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *RingQueue
-		expectedQueue  *RingQueue
-		expectedValue  any
+		queue          *RingQueue[string]
+		expectedQueue  *RingQueue[string]
+		expectedValue  string
 		expectedErr    error
 		expectedLength int
 	}
 
-	rQueueWithSingleItem := NewRingQueue()
+	rQueueWithSingleItem := NewRingQueue[string]()
 	rQueueWithSingleItem.Push("item")
 
-	rQueueWithMultipleItem := NewRingQueue()
+	rQueueWithMultipleItem := NewRingQueue[string]()
 	rQueueWithMultipleItem.Push("item1")
 	rQueueWithMultipleItem.Push("item2")
 
-	rQueueAfterPopOnrQueueWithMultipleItem := NewRingQueue()
+	rQueueAfterPopOnrQueueWithMultipleItem := NewRingQueue[string]()
 	rQueueAfterPopOnrQueueWithMultipleItem.Push("item2")
 
 	testScenarios := []scenario{
 		{
 			name:           "attempted pop on empty queue",
-			queue:          NewRingQueue(),
-			expectedQueue:  NewRingQueue(),
-			expectedValue:  nil,
+			queue:          NewRingQueue[string](),
+			expectedQueue:  NewRingQueue[string](),
+			expectedValue:  "",
 			expectedErr:    fmt.Errorf("pop attempted on empty queue"),
 			expectedLength: 0,
 		},
 		{
 			name:           "pop on queue with single item",
 			queue:          rQueueWithSingleItem,
-			expectedQueue:  NewRingQueue(),
+			expectedQueue:  NewRingQueue[string](),
 			expectedValue:  "item",
 			expectedErr:    nil,
 			expectedLength: 0,
@@ -188,37 +188,40 @@ func TestRingQueue_Pop(t *testing.T) {
 func TestRingQueue_Push(t *testing.T) {
 	type scenario struct {
 		name           string
-		queue          *RingQueue
-		input          any
-		expectedQueue  *RingQueue
+		queue          *RingQueue[string]
+		input          string
+		expectedQueue  *RingQueue[string]
 		expectedLength int
 	}
 
-	rQueueWithSingleItem := NewRingQueue()
+	rQueueWithSingleItem := NewRingQueue[string]()
 	rQueueWithSingleItem.Push("item1")
 
-	rQueueWithMultipleItem := NewRingQueue()
+	rQueueWithMultipleItem := NewRingQueue[string]()
 	rQueueWithMultipleItem.Push("item1")
 	rQueueWithMultipleItem.Push("item2")
 
+	rQueueWithZeroValue := NewRingQueue[string]()
+	rQueueWithZeroValue.Push("")
+
 	testScenarios := []scenario{
 		{
-			name:           "push nil",
-			queue:          NewRingQueue(),
-			input:          nil,
-			expectedQueue:  NewRingQueue(),
-			expectedLength: 0,
+			name:           "push zero value",
+			queue:          NewRingQueue[string](),
+			input:          "",
+			expectedQueue:  rQueueWithZeroValue,
+			expectedLength: 1,
 		},
 		{
 			name:           "push item on empty queue struct",
-			queue:          &RingQueue{},
+			queue:          &RingQueue[string]{},
 			input:          "item1",
 			expectedQueue:  rQueueWithSingleItem,
 			expectedLength: 1,
 		},
 		{
 			name:           "push item on well constructed empty queue",
-			queue:          NewRingQueue(),
+			queue:          NewRingQueue[string](),
 			input:          "item1",
 			expectedQueue:  rQueueWithSingleItem,
 			expectedLength: 1,
@@ -252,12 +255,12 @@ func TestRingQueue_Push_Resize(t *testing.T) {
 	// if the queue needs resizing, a push will do it if needed.
 	type scenario struct {
 		name               string
-		queue              *RingQueue
-		input              any
+		queue              *RingQueue[string]
+		input              string
 		expectedBufferSize int
 	}
 
-	rQueueWithMinSizeFilled := NewRingQueue()
+	rQueueWithMinSizeFilled := NewRingQueue[string]()
 
 	// fill the minQueueSize
 	for i := 0; i < 17; i++ {
@@ -267,8 +270,8 @@ func TestRingQueue_Push_Resize(t *testing.T) {
 	testScenarios := []scenario{
 		{
 			name:               "initial size is the minimumQueueSize",
-			queue:              NewRingQueue(),
-			input:              nil,
+			queue:              NewRingQueue[string](),
+			input:              "",
 			expectedBufferSize: minRingQueueSize,
 		},
 		{
@@ -293,11 +296,11 @@ func TestRingQueue_Pop_Resize(t *testing.T) {
 	// if the queue is less than half full, resize buffer to save space
 	type scenario struct {
 		name               string
-		queue              *RingQueue
+		queue              *RingQueue[string]
 		expectedBufferSize int
 	}
 
-	rQueueWithMinSizeFilledThenHalfPopped := NewRingQueue()
+	rQueueWithMinSizeFilledThenHalfPopped := NewRingQueue[string]()
 
 	// fill the minQueueSize and let it double to 32
 	for i := 0; i < 17; i++ {
@@ -326,3 +329,68 @@ func TestRingQueue_Pop_Resize(t *testing.T) {
 		})
 	}
 }
+
+func TestRingQueue_Pop_ClearsSlot(t *testing.T) {
+	// popped slots are reset to the zero value so the queue does not keep popped elements alive
+	item := "item"
+
+	queue := NewRingQueue[*string]()
+	queue.Push(&item)
+
+	popped, err := queue.Pop()
+	if err != nil {
+		test.ReportTestFailure(t, err, nil)
+	}
+
+	if popped != &item {
+		test.ReportTestFailure(t, popped, &item)
+	}
+
+	for i, element := range queue.Buffer {
+		if element != nil {
+			test.ReportTestFailure(t, fmt.Sprintf("buffer[%d] = %v", i, element), "nil")
+		}
+	}
+}
+
+func TestRingQueue_AllBackward(t *testing.T) {
+	q := NewRingQueue[int]()
+
+	// pop a few values first so the contents wrap around the end of the buffer
+	for i := 0; i < 10; i++ {
+		q.Push(i)
+	}
+	for i := 0; i < 8; i++ {
+		_, _ = q.Pop()
+	}
+	for i := 10; i < 20; i++ {
+		q.Push(i)
+	}
+
+	actualValues := make([]int, 0)
+	for value := range q.All() {
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues := []int{8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	actualValues = actualValues[:0]
+	for value := range q.Backward() {
+		if value < 17 {
+			break
+		}
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues = []int{19, 18, 17}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	if q.Length() != 12 {
+		test.ReportTestFailure(t, q.Length(), 12)
+	}
+}
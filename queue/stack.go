@@ -1,8 +1,13 @@
 package queue
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/devsquared/gods/collection"
+	"iter"
+)
 
-// TODO: let's test this and figure out how to return a nil T
+// ensure Stack satisfies the Reversible interface at compile time
+var _ collection.Reversible[any] = (*Stack[any])(nil)
 
 // Stack is a Last In, Last Out (LIFO) data structure. It is similar to a queue but with the difference being
 // how elements are popped off.
@@ -17,8 +22,9 @@ func (s *Stack[T]) Length() int {
 
 // Peek returns the top most element or last added element. This does not remove the element from the stack.
 func (s *Stack[T]) Peek() (T, error) {
-	/if len(s.coreSlice) == 0 {
-		return T{}, fmt.Errorf("stack is empty")
+	if len(s.coreSlice) == 0 {
+		var zero T
+		return zero, fmt.Errorf("stack is empty")
 	} else {
 		index := s.Length() - 1 // index of top most element
 		peeked := s.coreSlice[index]
@@ -29,7 +35,8 @@ func (s *Stack[T]) Peek() (T, error) {
 // Pop removes and returns the top most element or last added from the stack.
 func (s *Stack[T]) Pop() (T, error) {
 	if len(s.coreSlice) == 0 {
-		return T{}, fmt.Errorf("stack is empty")
+		var zero T
+		return zero, fmt.Errorf("stack is empty")
 	} else {
 		index := s.Length() - 1 // index of top most element
 		popped := s.coreSlice[index]
@@ -42,3 +49,27 @@ func (s *Stack[T]) Pop() (T, error) {
 func (s *Stack[T]) Push(element T) {
 	s.coreSlice = append(s.coreSlice, element)
 }
+
+// All returns an iterator over the elements of the stack from the top down, which is the order they would be popped
+// in. The stack must not be changed while iterating over it.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.coreSlice) - 1; i >= 0; i-- {
+			if !yield(s.coreSlice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the stack from the bottom up, which is the order they were pushed
+// in. The stack must not be changed while iterating over it.
+func (s *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range s.coreSlice {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
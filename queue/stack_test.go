@@ -0,0 +1,42 @@
+package queue
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestStack_AllBackward(t *testing.T) {
+	s := &Stack[int]{}
+	for i := 1; i <= 4; i++ {
+		s.Push(i)
+	}
+
+	actualValues := make([]int, 0)
+	for value := range s.All() {
+		actualValues = append(actualValues, value)
+	}
+
+	// a stack iterates from the top down, the same order it pops in
+	expectedValues := []int{4, 3, 2, 1}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	actualValues = actualValues[:0]
+	for value := range s.Backward() {
+		if value > 2 {
+			break
+		}
+		actualValues = append(actualValues, value)
+	}
+
+	expectedValues = []int{1, 2}
+	if !cmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+
+	if s.Length() != 4 {
+		test.ReportTestFailure(t, s.Length(), 4)
+	}
+}
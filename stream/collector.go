@@ -0,0 +1,256 @@
+package stream
+
+import (
+	"github.com/devsquared/gods/collection"
+	"strings"
+)
+
+// Number is any integer or floating point type, as summed by Summarizing.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Collector describes a terminal operation that folds the values of a stream into a result of type R. Collectors are
+// run with Collect or CollectParallel, and some collectors, such as GroupByWith, take another collector to apply to
+// part of the values. A Collector holds no state of its own, so the same one can be used any number of times.
+type Collector[T, R any] struct {
+	start func() (add func(value T), finish func() R)
+}
+
+// NewCollector constructs a Collector from the three steps of a fold: supply creates a new accumulator, accumulate adds
+// a value to it and returns the updated accumulator, and finish turns the accumulator into the result once every value
+// has been added.
+func NewCollector[T, A, R any](
+	supply func() A,
+	accumulate func(acc A, value T) A,
+	finish func(acc A) R,
+) Collector[T, R] {
+	return Collector[T, R]{
+		start: func() (func(value T), func() R) {
+			acc := supply()
+			add := func(value T) {
+				acc = accumulate(acc, value)
+			}
+
+			return add, func() R { return finish(acc) }
+		},
+	}
+}
+
+// Collect runs the pipeline and folds its values into a result with the collector.
+func Collect[T, R any](s *Stream[T], collector Collector[T, R]) R {
+	add, finish := collector.start()
+	for value := range s.seq {
+		add(value)
+	}
+
+	return finish()
+}
+
+// CollectParallel runs the parallel pipeline and folds its values into a result with the collector. The collector is
+// only ever called from the calling goroutine, so it need not be safe for concurrent use. It returns the context's
+// error when the context is cancelled first.
+func CollectParallel[T, R any](p *ParallelStream[T], collector Collector[T, R]) (R, error) {
+	add, finish := collector.start()
+	if err := p.ForEach(add); err != nil {
+		var zero R
+		return zero, err
+	}
+
+	return finish(), nil
+}
+
+// ToList returns a Collector that gathers the values into a new List in order.
+func ToList[T any]() Collector[T, *collection.List[T]] {
+	return NewCollector(collection.NewList[T],
+		func(list *collection.List[T], value T) *collection.List[T] {
+			list.Add(value)
+			return list
+		},
+		identity[*collection.List[T]],
+	)
+}
+
+// ToSet returns a Collector that gathers the values into a new Set, which only keeps one of any repeated value.
+func ToSet[T comparable]() Collector[T, *collection.Set[T]] {
+	return NewCollector(collection.NewSet[T],
+		func(set *collection.Set[T], value T) *collection.Set[T] {
+			set.Add(value)
+			return set
+		},
+		identity[*collection.Set[T]],
+	)
+}
+
+// ToMap returns a Collector that gathers the key and value that key and value give for each value into a new map. When
+// several values give the same key, merge is called with the value already in the map and the new one, and the map
+// keeps what it returns. A nil merge keeps the last value for each key.
+func ToMap[T any, K comparable, V any](
+	key func(value T) K,
+	value func(value T) V,
+	merge func(existing, incoming V) V,
+) Collector[T, map[K]V] {
+	return NewCollector(
+		func() map[K]V { return make(map[K]V) },
+		func(collected map[K]V, v T) map[K]V {
+			k, incoming := key(v), value(v)
+			if existing, ok := collected[k]; ok && merge != nil {
+				incoming = merge(existing, incoming)
+			}
+
+			collected[k] = incoming
+			return collected
+		},
+		identity[map[K]V],
+	)
+}
+
+// GroupBy returns a Collector that groups the values by the key that key gives for each, into a map from each key to a
+// List of its values in order.
+func GroupBy[T any, K comparable](key func(value T) K) Collector[T, map[K]*collection.List[T]] {
+	return GroupByWith(key, ToList[T]())
+}
+
+// GroupByWith returns a Collector that groups the values by the key that key gives for each, and collects the values
+// of each group with the downstream collector. For example, GroupByWith(key, Counting[T]()) counts the values with
+// each key.
+func GroupByWith[T any, K comparable, R any](key func(value T) K, downstream Collector[T, R]) Collector[T, map[K]R] {
+	type group struct {
+		add    func(value T)
+		finish func() R
+	}
+
+	return NewCollector(
+		func() map[K]*group { return make(map[K]*group) },
+		func(groups map[K]*group, value T) map[K]*group {
+			k := key(value)
+			g, ok := groups[k]
+			if !ok {
+				g = &group{}
+				g.add, g.finish = downstream.start()
+				groups[k] = g
+			}
+
+			g.add(value)
+			return groups
+		},
+		func(groups map[K]*group) map[K]R {
+			collected := make(map[K]R, len(groups))
+			for k, g := range groups {
+				collected[k] = g.finish()
+			}
+
+			return collected
+		},
+	)
+}
+
+// PartitionBy returns a Collector that splits the values in two by predicate, into a map from true to a List of the
+// values predicate is true for and from false to a List of the rest. Both keys are always present.
+func PartitionBy[T any](predicate func(value T) bool) Collector[T, map[bool]*collection.List[T]] {
+	return PartitionByWith(predicate, ToList[T]())
+}
+
+// PartitionByWith returns a Collector that splits the values in two by predicate and collects each half with the
+// downstream collector. Both keys are always present, even when no values fall on one side.
+func PartitionByWith[T, R any](predicate func(value T) bool, downstream Collector[T, R]) Collector[T, map[bool]R] {
+	return Collector[T, map[bool]R]{
+		start: func() (func(value T), func() map[bool]R) {
+			addMatched, finishMatched := downstream.start()
+			addUnmatched, finishUnmatched := downstream.start()
+
+			add := func(value T) {
+				if predicate(value) {
+					addMatched(value)
+				} else {
+					addUnmatched(value)
+				}
+			}
+			finish := func() map[bool]R {
+				return map[bool]R{true: finishMatched(), false: finishUnmatched()}
+			}
+
+			return add, finish
+		},
+	}
+}
+
+// Counting returns a Collector that counts the values.
+func Counting[T any]() Collector[T, int] {
+	return NewCollector(
+		func() int { return 0 },
+		func(count int, _ T) int { return count + 1 },
+		identity[int],
+	)
+}
+
+// Joining returns a Collector that concatenates the values in order with separator between each of them. Streams of
+// other types can be mapped to strings first.
+func Joining(separator string) Collector[string, string] {
+	type joiner struct {
+		builder strings.Builder
+		started bool // whether a value has been added; an empty first value still needs a separator after it
+	}
+
+	return NewCollector(
+		func() *joiner { return &joiner{} },
+		func(joined *joiner, value string) *joiner {
+			if joined.started {
+				joined.builder.WriteString(separator)
+			}
+
+			joined.builder.WriteString(value)
+			joined.started = true
+			return joined
+		},
+		func(joined *joiner) string { return joined.builder.String() },
+	)
+}
+
+// Summary holds statistics about a stream of numbers, as gathered by Summarizing. Min and Max are the zero value when
+// Count is 0. Sum is an exact N, so like any arithmetic on N it wraps around once the total leaves the range of N. To
+// total a small integer type, have the value function of Summarizing widen it, for example to an int64.
+type Summary[N Number] struct {
+	Count int
+	Sum   N
+	Min   N
+	Max   N
+}
+
+// Mean returns the average of the numbers, or 0 when there were none.
+func (s Summary[N]) Mean() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+
+	return float64(s.Sum) / float64(s.Count)
+}
+
+// Summarizing returns a Collector that gathers the count, sum, least and greatest of the number that value gives for
+// each value. The mean is available from the Summary. For a stream of numbers, value can return each value unchanged.
+func Summarizing[T any, N Number](value func(value T) N) Collector[T, Summary[N]] {
+	return NewCollector(
+		func() Summary[N] { return Summary[N]{} },
+		func(summary Summary[N], v T) Summary[N] {
+			n := value(v)
+			if summary.Count == 0 || n < summary.Min {
+				summary.Min = n
+			}
+			if summary.Count == 0 || n > summary.Max {
+				summary.Max = n
+			}
+
+			summary.Count++
+			summary.Sum += n
+			return summary
+		},
+		identity[Summary[N]],
+	)
+}
+
+// identity returns its argument unchanged, for collectors whose accumulator is already their result.
+func identity[T any](value T) T {
+	return value
+}
@@ -0,0 +1,291 @@
+package stream
+
+import (
+	"context"
+	"github.com/devsquared/gods/collection"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// employee is a simple record used to exercise the collectors.
+type employee struct {
+	name   string
+	team   string
+	salary int
+}
+
+var employees = []employee{
+	{name: "ana", team: "data", salary: 120},
+	{name: "ben", team: "web", salary: 90},
+	{name: "cy", team: "data", salary: 100},
+	{name: "di", team: "ops", salary: 80},
+	{name: "ed", team: "web", salary: 110},
+}
+
+func employeeTeam(e employee) string {
+	return e.team
+}
+
+func employeeName(e employee) string {
+	return e.name
+}
+
+// listValues reads the values of a list in order so they can be compared against slices.
+func listValues[T any](list *collection.List[T]) []T {
+	return slices.AppendSeq(make([]T, 0, list.Length()), list.All())
+}
+
+func TestCollect_ToCollections(t *testing.T) {
+	t.Run("to list", func(t *testing.T) {
+		actualValues := listValues(Collect(Of(3, 1, 2), ToList[int]()))
+
+		expectedValues := []int{3, 1, 2}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("to set", func(t *testing.T) {
+		actualValues := slices.Sorted(Collect(Of(3, 1, 3, 2), ToSet[int]()).All())
+
+		expectedValues := []int{1, 2, 3}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("to map with merge", func(t *testing.T) {
+		payroll := Collect(FromSlice(employees), ToMap(employeeTeam,
+			func(e employee) int { return e.salary },
+			func(existing, incoming int) int { return existing + incoming },
+		))
+
+		expected := map[string]int{"data": 220, "web": 200, "ops": 80}
+		if !gocmp.Equal(payroll, expected) {
+			test.ReportTestFailure(t, payroll, expected)
+		}
+	})
+
+	t.Run("to map without merge keeps the last value", func(t *testing.T) {
+		lastOfTeam := Collect(FromSlice(employees), ToMap(employeeTeam, employeeName, nil))
+
+		expected := map[string]string{"data": "cy", "web": "ed", "ops": "di"}
+		if !gocmp.Equal(lastOfTeam, expected) {
+			test.ReportTestFailure(t, lastOfTeam, expected)
+		}
+	})
+
+	t.Run("a collector can be used more than once", func(t *testing.T) {
+		counting := Counting[int]()
+
+		if count := Collect(Of(1, 2, 3), counting); count != 3 {
+			test.ReportTestFailure(t, count, 3)
+		}
+
+		if count := Collect(Of(1, 2), counting); count != 2 {
+			test.ReportTestFailure(t, count, 2)
+		}
+	})
+}
+
+func TestCollect_GroupBy(t *testing.T) {
+	t.Run("group into lists", func(t *testing.T) {
+		groups := Collect(FromSlice(employees), GroupBy(employeeTeam))
+
+		actual := make(map[string][]string)
+		for team, members := range groups {
+			actual[team] = listValues(Map(FromList(members), employeeName).ToList())
+		}
+
+		expected := map[string][]string{"data": {"ana", "cy"}, "web": {"ben", "ed"}, "ops": {"di"}}
+		if !gocmp.Equal(actual, expected) {
+			test.ReportTestFailure(t, actual, expected)
+		}
+	})
+
+	t.Run("group with a downstream collector", func(t *testing.T) {
+		counts := Collect(FromSlice(employees), GroupByWith(employeeTeam, Counting[employee]()))
+
+		expected := map[string]int{"data": 2, "web": 2, "ops": 1}
+		if !gocmp.Equal(counts, expected) {
+			test.ReportTestFailure(t, counts, expected)
+		}
+	})
+
+	t.Run("group nothing", func(t *testing.T) {
+		groups := Collect(Of[employee](), GroupBy(employeeTeam))
+
+		if len(groups) != 0 {
+			test.ReportTestFailure(t, groups, map[string]*collection.List[employee]{})
+		}
+	})
+}
+
+func TestCollect_PartitionBy(t *testing.T) {
+	type testScenario struct {
+		name              string
+		values            []int
+		expectedMatched   []int
+		expectedUnmatched []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "partition nothing", values: []int{}, expectedMatched: []int{}, expectedUnmatched: []int{}},
+		{name: "partition both ways", values: []int{1, 2, 3, 4}, expectedMatched: []int{2, 4}, expectedUnmatched: []int{1, 3}},
+		{name: "partition one way", values: []int{2, 4}, expectedMatched: []int{2, 4}, expectedUnmatched: []int{}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			partitions := Collect(FromSlice(ts.values), PartitionBy(isEven))
+
+			// both keys are present even when a side is empty
+			if len(partitions) != 2 {
+				test.ReportTestFailure(t, len(partitions), 2)
+			}
+
+			if actualMatched := listValues(partitions[true]); !gocmp.Equal(actualMatched, ts.expectedMatched) {
+				test.ReportTestFailure(t, actualMatched, ts.expectedMatched)
+			}
+
+			if actualUnmatched := listValues(partitions[false]); !gocmp.Equal(actualUnmatched, ts.expectedUnmatched) {
+				test.ReportTestFailure(t, actualUnmatched, ts.expectedUnmatched)
+			}
+		})
+	}
+
+	t.Run("partition with a downstream collector", func(t *testing.T) {
+		wellPaid := func(e employee) bool { return e.salary >= 100 }
+		names := Collect(FromSlice(employees), PartitionByWith(wellPaid, NewCollector(
+			func() []string { return nil },
+			func(names []string, e employee) []string { return append(names, e.name) },
+			func(names []string) string { return strings.Join(names, "+") },
+		)))
+
+		expected := map[bool]string{true: "ana+cy+ed", false: "ben+di"}
+		if !gocmp.Equal(names, expected) {
+			test.ReportTestFailure(t, names, expected)
+		}
+	})
+}
+
+func TestCollect_Joining(t *testing.T) {
+	type testScenario struct {
+		name      string
+		values    []string
+		separator string
+		expected  string
+	}
+
+	testScenarios := []testScenario{
+		{name: "join nothing", values: []string{}, separator: ", ", expected: ""},
+		{name: "join one value", values: []string{"a"}, separator: ", ", expected: "a"},
+		{name: "join many values", values: []string{"a", "b", "c"}, separator: ", ", expected: "a, b, c"},
+		{name: "join without a separator", values: []string{"a", "b", "c"}, separator: "", expected: "abc"},
+		{name: "join empty values", values: []string{"", "", "c"}, separator: "-", expected: "--c"},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actual := Collect(FromSlice(ts.values), Joining(ts.separator)); actual != ts.expected {
+				test.ReportTestFailure(t, actual, ts.expected)
+			}
+		})
+	}
+
+	t.Run("join mapped values", func(t *testing.T) {
+		actual := Collect(Map(Of(1, 2, 3), strconv.Itoa), Joining("|"))
+		if actual != "1|2|3" {
+			test.ReportTestFailure(t, actual, "1|2|3")
+		}
+	})
+}
+
+func TestCollect_Summarizing(t *testing.T) {
+	t.Run("summarize a field", func(t *testing.T) {
+		summary := Collect(FromSlice(employees), Summarizing(func(e employee) int { return e.salary }))
+
+		expected := Summary[int]{Count: 5, Sum: 500, Min: 80, Max: 120}
+		if summary != expected {
+			test.ReportTestFailure(t, summary, expected)
+		}
+
+		if summary.Mean() != 100 {
+			test.ReportTestFailure(t, summary.Mean(), 100)
+		}
+	})
+
+	t.Run("summarize negative floats", func(t *testing.T) {
+		summary := Collect(Of(-1.5, -3.0, -0.5), Summarizing(func(value float64) float64 { return value }))
+
+		expected := Summary[float64]{Count: 3, Sum: -5, Min: -3, Max: -0.5}
+		if summary != expected {
+			test.ReportTestFailure(t, summary, expected)
+		}
+	})
+
+	t.Run("summarize small integers widened past their range", func(t *testing.T) {
+		summary := Collect(Of[int8](100, 100, 100), Summarizing(func(value int8) int64 { return int64(value) }))
+
+		expected := Summary[int64]{Count: 3, Sum: 300, Min: 100, Max: 100}
+		if summary != expected {
+			test.ReportTestFailure(t, summary, expected)
+		}
+
+		if summary.Mean() != 100 {
+			test.ReportTestFailure(t, summary.Mean(), 100)
+		}
+	})
+
+	t.Run("summarize integers past the precision of a float64", func(t *testing.T) {
+		summary := Collect(Of[int64](1<<53, 1, 1), Summarizing(func(value int64) int64 { return value }))
+
+		expected := Summary[int64]{Count: 3, Sum: 1<<53 + 2, Min: 1, Max: 1 << 53}
+		if summary != expected {
+			test.ReportTestFailure(t, summary, expected)
+		}
+	})
+
+	t.Run("summarize nothing", func(t *testing.T) {
+		summary := Collect(Of[int](), Summarizing(func(value int) int { return value }))
+
+		if summary != (Summary[int]{}) || summary.Mean() != 0 {
+			test.ReportTestFailure(t, summary, Summary[int]{})
+		}
+	})
+
+	t.Run("summarize each group", func(t *testing.T) {
+		salaries := Collect(FromSlice(employees), GroupByWith(employeeTeam,
+			Summarizing(func(e employee) int { return e.salary }),
+		))
+
+		if mean := salaries["data"].Mean(); mean != 110 {
+			test.ReportTestFailure(t, mean, 110)
+		}
+	})
+}
+
+func TestCollectParallel(t *testing.T) {
+	ctx := context.Background()
+	values := FromSeq(naturals).Limit(1_000).ToSlice()
+
+	counts, err := CollectParallel(FromSlice(values).Parallel(ctx, 4, WithChunkSize(16)), GroupByWith(isEven, Counting[int]()))
+	if err != nil {
+		test.ReportTestFailure(t, err, nil)
+	}
+
+	expected := map[bool]int{true: 500, false: 500}
+	if !gocmp.Equal(counts, expected) {
+		test.ReportTestFailure(t, counts, expected)
+	}
+
+	joined, err := CollectParallel(ParallelMap(Of(1, 2, 3).Parallel(ctx, 2, WithChunkSize(1), WithEncounterOrder()),
+		strconv.Itoa), Joining(","))
+	if err != nil || joined != "1,2,3" {
+		test.ReportTestFailure(t, joined, "1,2,3")
+	}
+}
@@ -0,0 +1,63 @@
+package stream
+
+import "iter"
+
+// Concat returns a Stream of every value of the first stream, followed by every value of the second, and so on.
+func Concat[T any](streams ...*Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, s := range streams {
+			for value := range s.seq {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Zip returns a Stream of the result of combine for each pair of values at the same position in a and b. It stops as
+// soon as either stream runs out.
+func Zip[A, B, R any](a *Stream[A], b *Stream[B], combine func(a A, b B) R) *Stream[R] {
+	return FromSeq(func(yield func(R) bool) {
+		nextB, stop := iter.Pull(b.seq)
+		defer stop()
+
+		for valueA := range a.seq {
+			valueB, ok := nextB()
+			if !ok || !yield(combine(valueA, valueB)) {
+				return
+			}
+		}
+	})
+}
+
+// Interleave returns a Stream that takes one value from each stream in turn. Streams that run out are passed over, so
+// the rest carry on until every stream is done.
+func Interleave[T any](streams ...*Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), 0, len(streams))
+		for _, s := range streams {
+			next, stop := iter.Pull(s.seq)
+			defer stop()
+
+			nexts = append(nexts, next)
+		}
+
+		for len(nexts) > 0 {
+			active := nexts[:0]
+			for _, next := range nexts {
+				value, ok := next()
+				if !ok {
+					continue
+				}
+
+				if !yield(value) {
+					return
+				}
+				active = append(active, next)
+			}
+
+			nexts = active
+		}
+	})
+}
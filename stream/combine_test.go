@@ -0,0 +1,116 @@
+package stream
+
+import (
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"strconv"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	type testScenario struct {
+		name           string
+		streams        []*Stream[int]
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "concat no streams", streams: nil, expectedValues: []int{}},
+		{name: "concat empty streams", streams: []*Stream[int]{Of[int](), Of[int]()}, expectedValues: []int{}},
+		{name: "concat streams", streams: []*Stream[int]{Of(1, 2), Of[int](), Of(3)}, expectedValues: []int{1, 2, 3}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualValues := Concat(ts.streams...).ToSlice(); !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+
+	t.Run("stop before reading later streams", func(t *testing.T) {
+		read := 0
+		later := Of(3, 4).Peek(func(int) { read++ })
+
+		actualValues := Concat(Of(1, 2), later).Limit(2).ToSlice()
+		if !gocmp.Equal(actualValues, []int{1, 2}) || read != 0 {
+			test.ReportTestFailure(t, read, 0)
+		}
+	})
+}
+
+func TestZip(t *testing.T) {
+	label := func(number int, letter string) string {
+		return strconv.Itoa(number) + letter
+	}
+
+	type testScenario struct {
+		name           string
+		numbers        *Stream[int]
+		letters        *Stream[string]
+		expectedValues []string
+	}
+
+	testScenarios := []testScenario{
+		{name: "zip empty streams", numbers: Of[int](), letters: Of[string](), expectedValues: []string{}},
+		{name: "zip equal lengths", numbers: Of(1, 2), letters: Of("a", "b"), expectedValues: []string{"1a", "2b"}},
+		{name: "zip shorter first", numbers: Of(1), letters: Of("a", "b"), expectedValues: []string{"1a"}},
+		{name: "zip shorter second", numbers: Of(1, 2, 3), letters: Of("a"), expectedValues: []string{"1a"}},
+		{
+			name:           "zip with an endless stream",
+			numbers:        FromSeq(naturals),
+			letters:        Of("a", "b", "c"),
+			expectedValues: []string{"1a", "2b", "3c"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualValues := Zip(ts.numbers, ts.letters, label).ToSlice(); !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	type testScenario struct {
+		name           string
+		streams        []*Stream[int]
+		expectedValues []int
+	}
+
+	testScenarios := []testScenario{
+		{name: "interleave no streams", streams: nil, expectedValues: []int{}},
+		{name: "interleave one stream", streams: []*Stream[int]{Of(1, 2)}, expectedValues: []int{1, 2}},
+		{
+			name:           "interleave equal lengths",
+			streams:        []*Stream[int]{Of(1, 4), Of(2, 5), Of(3, 6)},
+			expectedValues: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:           "interleave uneven lengths",
+			streams:        []*Stream[int]{Of(1), Of(2, 4, 6), Of[int](), Of(3, 5)},
+			expectedValues: []int{1, 2, 3, 4, 5, 6},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualValues := Interleave(ts.streams...).ToSlice(); !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+
+	t.Run("interleave endless streams", func(t *testing.T) {
+		evens := FromSeq(naturals).Filter(isEven)
+		odds := FromSeq(naturals).Filter(func(value int) bool { return !isEven(value) })
+
+		actualValues := Interleave(odds, evens).Limit(6).ToSlice()
+		expectedValues := []int{1, 2, 3, 4, 5, 6}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+}
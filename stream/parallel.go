@@ -0,0 +1,375 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"github.com/devsquared/gods/collection"
+	"iter"
+	"runtime/debug"
+	"sync"
+)
+
+// defaultChunkSize is how many values a parallel stream hands to a worker at a time unless WithChunkSize says
+// otherwise. Chunks amortize the cost of passing work between goroutines over many values.
+const defaultChunkSize = 256
+
+// ParallelOption configures optional behaviour of a ParallelStream when it is created.
+type ParallelOption func(*parallelOptions)
+
+type parallelOptions struct {
+	workers   int
+	chunkSize int
+	ordered   bool
+	ctx       context.Context
+}
+
+// WithEncounterOrder makes the terminal operation see values in the order the source gave them, as a sequential
+// stream would. Without it, each chunk of values is passed on as soon as a worker finishes it, which keeps the workers
+// busier when chunks take uneven time.
+func WithEncounterOrder() ParallelOption {
+	return func(options *parallelOptions) {
+		options.ordered = true
+	}
+}
+
+// WithChunkSize sets how many values a worker is handed at a time. Smaller chunks spread uneven work more evenly, while
+// larger chunks lower the overhead for cheap operations. A size below 1 panics.
+func WithChunkSize(size int) ParallelOption {
+	if size < 1 {
+		panic("stream: chunk size must be at least 1")
+	}
+
+	return func(options *parallelOptions) {
+		options.chunkSize = size
+	}
+}
+
+// ParallelStream is a Stream whose operations run on a bounded pool of goroutines. The source is read on a single
+// goroutine and split into chunks, each worker runs every stage of the pipeline over a whole chunk at a time, and the
+// terminal operation combines the chunks back together on the calling goroutine. At most twice as many chunks as there
+// are workers are in flight at once, so a large or endless source is never read far ahead of the terminal operation.
+//
+// Functions given to a ParallelStream's operations are called from several goroutines at once and so must be safe
+// for concurrent use. Functions given to its terminal operations are only called from the calling goroutine. A panic
+// in any stage, or in the source, stops the stream and is raised again from the terminal operation as a *PanicError.
+//
+// Cancelling the context stops the stream, making its terminal operation return the context's error. Workers finish
+// the chunk they are on, but no further chunks are started.
+type ParallelStream[T any] struct {
+	tasks   iter.Seq[func() []T] // splits the source into chunks, each with the work that turns it into values of T
+	options parallelOptions
+}
+
+// PanicError is the value a ParallelStream's terminal operation panics with when a stage or the source panicked on
+// another goroutine. It holds the original panic value along with the stack of the goroutine that panicked, which
+// would otherwise be lost when the panic is raised again.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error returns the original panic value followed by the stack it was raised from.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v\n\ngoroutine stack:\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the original panic value when it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// parallelTask is a chunk of work along with its position in the source.
+type parallelTask[T any] struct {
+	index int
+	run   func() []T
+}
+
+// parallelResult is the values a worker made from the chunk at index.
+type parallelResult[T any] struct {
+	index  int
+	values []T
+}
+
+// Parallel returns a ParallelStream that runs the rest of the pipeline on the given number of workers until it is done
+// or ctx is cancelled. Operations before Parallel still run on the goroutine reading the source. It panics when
+// workers is below 1.
+func (s *Stream[T]) Parallel(ctx context.Context, workers int, options ...ParallelOption) *ParallelStream[T] {
+	if workers < 1 {
+		panic("stream: workers must be at least 1")
+	}
+
+	applied := parallelOptions{
+		workers:   workers,
+		chunkSize: defaultChunkSize,
+		ctx:       ctx,
+	}
+	for _, option := range options {
+		option(&applied)
+	}
+
+	return &ParallelStream[T]{
+		tasks:   split(s.seq, applied.chunkSize),
+		options: applied,
+	}
+}
+
+// Filter returns a ParallelStream of only the values that keep reports true for.
+func (p *ParallelStream[T]) Filter(keep func(value T) bool) *ParallelStream[T] {
+	return withStage(p, func(values []T) []T {
+		kept := values[:0]
+		for _, value := range values {
+			if keep(value) {
+				kept = append(kept, value)
+			}
+		}
+
+		clear(values[len(kept):])
+		return kept
+	})
+}
+
+// Peek returns a ParallelStream of the same values that calls fn with each value as it passes through.
+func (p *ParallelStream[T]) Peek(fn func(value T)) *ParallelStream[T] {
+	return withStage(p, func(values []T) []T {
+		for _, value := range values {
+			fn(value)
+		}
+
+		return values
+	})
+}
+
+// ForEach runs the pipeline, calling fn on the calling goroutine with each value. It returns the context's error when
+// the context is cancelled before every value has been passed to fn.
+func (p *ParallelStream[T]) ForEach(fn func(value T)) error {
+	return p.run(func(values []T) {
+		for _, value := range values {
+			fn(value)
+		}
+	})
+}
+
+// Count runs the pipeline and returns the number of values that came out of it, or the context's error when the
+// context is cancelled first.
+func (p *ParallelStream[T]) Count() (int, error) {
+	count := 0
+	err := p.run(func(values []T) {
+		count += len(values)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ToSlice runs the pipeline and returns its values in a new slice, or the context's error when the context is
+// cancelled first.
+func (p *ParallelStream[T]) ToSlice() ([]T, error) {
+	collected := make([]T, 0)
+	err := p.run(func(values []T) {
+		collected = append(collected, values...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collected, nil
+}
+
+// ToList runs the pipeline and returns its values in a new List, or the context's error when the context is cancelled
+// first.
+func (p *ParallelStream[T]) ToList() (*collection.List[T], error) {
+	values, err := p.ToSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	return collection.NewListFromSlice(values), nil
+}
+
+// run executes the pipeline on the pool of workers, calling emit on the calling goroutine with the values of each
+// chunk, in encounter order when the stream is ordered. Every goroutine it starts has finished by the time it returns.
+func (p *ParallelStream[T]) run(emit func(values []T)) error {
+	ctx, cancel := context.WithCancel(p.options.ctx)
+	defer cancel()
+
+	var (
+		wg         sync.WaitGroup
+		failOnce   sync.Once
+		failure    *PanicError
+		dispatched int  // chunks handed to the workers; only read once every goroutine is done
+		exhausted  bool // whether the whole source was read; only read once every goroutine is done
+	)
+
+	// fail records the first panic and stops the rest of the stream
+	fail := func(recovered *PanicError) {
+		failOnce.Do(func() {
+			failure = recovered
+			cancel()
+		})
+	}
+
+	tasks := make(chan parallelTask[T], p.options.workers)
+	results := make(chan parallelResult[T], p.options.workers)
+
+	// slots bounds the chunks in flight, including any an ordered stream holds back while an earlier chunk finishes
+	slots := make(chan struct{}, 2*p.options.workers)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(tasks)
+		defer func() {
+			if r := recover(); r != nil {
+				fail(&PanicError{Value: r, Stack: debug.Stack()})
+			}
+		}()
+
+		for task := range p.tasks {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case tasks <- parallelTask[T]{index: dispatched, run: task}:
+				dispatched++
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		exhausted = true
+	}()
+
+	for range p.options.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for task := range tasks {
+				// chunks already handed over are dropped rather than started once the stream has stopped
+				if ctx.Err() != nil {
+					return
+				}
+
+				values, ok := runTask(task.run, fail)
+				if !ok {
+					continue
+				}
+
+				select {
+				case results <- parallelResult[T]{index: task.index, values: values}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	emitted := 0
+	next := 0
+	pending := make(map[int][]T)
+	for result := range results {
+		// once the stream has stopped, keep draining so every worker can finish
+		if ctx.Err() != nil {
+			continue
+		}
+
+		if !p.options.ordered {
+			<-slots
+			emit(result.values)
+			emitted++
+			continue
+		}
+
+		pending[result.index] = result.values
+		for values, ok := pending[next]; ok; values, ok = pending[next] {
+			delete(pending, next)
+			next++
+
+			<-slots
+			emit(values)
+			emitted++
+		}
+	}
+
+	if failure != nil {
+		panic(failure)
+	}
+
+	if !exhausted || emitted < dispatched {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// ParallelMap returns a ParallelStream of the result of fn for each value of the ParallelStream.
+func ParallelMap[T, U any](p *ParallelStream[T], fn func(value T) U) *ParallelStream[U] {
+	return withStage(p, func(values []T) []U {
+		mapped := make([]U, len(values))
+		for i, value := range values {
+			mapped[i] = fn(value)
+		}
+
+		return mapped
+	})
+}
+
+// withStage returns a ParallelStream that runs stage over each chunk after the stages already in p.
+func withStage[T, U any](p *ParallelStream[T], stage func(values []T) []U) *ParallelStream[U] {
+	return &ParallelStream[U]{
+		tasks: func(yield func(func() []U) bool) {
+			for task := range p.tasks {
+				if !yield(func() []U { return stage(task()) }) {
+					return
+				}
+			}
+		},
+		options: p.options,
+	}
+}
+
+// split groups the values of seq into chunks of up to size values, each as a task that gives the chunk back.
+func split[T any](seq iter.Seq[T], size int) iter.Seq[func() []T] {
+	return func(yield func(func() []T) bool) {
+		chunk := make([]T, 0, size)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) < size {
+				continue
+			}
+
+			full := chunk
+			if !yield(func() []T { return full }) {
+				return
+			}
+			chunk = make([]T, 0, size)
+		}
+
+		if len(chunk) > 0 {
+			yield(func() []T { return chunk })
+		}
+	}
+}
+
+// runTask runs the task, passing any panic to fail along with the worker's stack instead of letting it crash the
+// worker. ok is false when the task panicked.
+func runTask[T any](run func() []T, fail func(recovered *PanicError)) (values []T, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			fail(&PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+
+	return run(), true
+}
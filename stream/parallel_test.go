@@ -0,0 +1,310 @@
+package stream
+
+import (
+	"context"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// churn is a stand-in for a CPU-bound map, spinning for a while so that work is spread over the workers.
+func churn(value int) int {
+	result := value
+	for i := 0; i < 1_000; i++ {
+		result = (result*31 + i) % 1_000_003
+	}
+
+	return result
+}
+
+// checkNoLeakedGoroutines fails the test when there are still more goroutines running than before it started.
+func checkNoLeakedGoroutines(t *testing.T, before int) {
+	t.Helper()
+
+	// goroutines that have been told to stop may take a moment to be scheduled and return
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		test.ReportTestFailure(t, after, before)
+	}
+}
+
+func TestParallelStream_Results(t *testing.T) {
+	type testScenario struct {
+		name      string
+		workers   int
+		chunkSize int
+		ordered   bool
+	}
+
+	testScenarios := []testScenario{
+		{name: "one worker in order", workers: 1, chunkSize: 10, ordered: true},
+		{name: "many workers in order", workers: 8, chunkSize: 7, ordered: true},
+		{name: "many workers in any order", workers: 8, chunkSize: 7},
+		{name: "chunks of one value", workers: 4, chunkSize: 1, ordered: true},
+		{name: "single chunk", workers: 4, chunkSize: 10_000, ordered: true},
+	}
+
+	values := make([]int, 1_000)
+	for i := range values {
+		values[i] = i
+	}
+
+	ctx := context.Background()
+	expectedValues := Map(FromSlice(values).Filter(isEven), churn).ToSlice()
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			options := []ParallelOption{WithChunkSize(ts.chunkSize)}
+			if ts.ordered {
+				options = append(options, WithEncounterOrder())
+			}
+
+			p := ParallelMap(FromSlice(values).Parallel(ctx, ts.workers, options...).Filter(isEven), churn)
+			actualValues, err := p.ToSlice()
+			if err != nil {
+				test.ReportTestFailure(t, err, nil)
+			}
+
+			expectedValues := expectedValues
+			if !ts.ordered {
+				// without encounter order only the values themselves are certain, so compare them sorted
+				actualValues = slices.Sorted(slices.Values(actualValues))
+				expectedValues = slices.Sorted(slices.Values(expectedValues))
+			}
+
+			if !gocmp.Equal(actualValues, expectedValues) {
+				test.ReportTestFailure(t, actualValues, expectedValues)
+			}
+		})
+	}
+
+	t.Run("empty source", func(t *testing.T) {
+		actualValues, err := Of[int]().Parallel(ctx, 4).ToSlice()
+		if err != nil || len(actualValues) != 0 {
+			test.ReportTestFailure(t, actualValues, []int{})
+		}
+	})
+}
+
+func TestParallelStream_Terminal(t *testing.T) {
+	p := FromSeq(naturals).Limit(500).Parallel(context.Background(), 4, WithChunkSize(16), WithEncounterOrder())
+
+	t.Run("count", func(t *testing.T) {
+		count, err := p.Filter(isEven).Count()
+		if err != nil || count != 250 {
+			test.ReportTestFailure(t, count, 250)
+		}
+	})
+
+	t.Run("for each runs on the calling goroutine in order", func(t *testing.T) {
+		// appending without a lock is only safe because fn is never called concurrently
+		actualValues := make([]int, 0)
+		err := p.ForEach(func(value int) {
+			actualValues = append(actualValues, value)
+		})
+		if err != nil {
+			test.ReportTestFailure(t, err, nil)
+		}
+
+		expectedValues := FromSeq(naturals).Limit(500).ToSlice()
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("peek sees every value", func(t *testing.T) {
+		var peeked atomic.Int64
+		list, err := p.Peek(func(int) { peeked.Add(1) }).ToList()
+		if err != nil || list.Length() != 500 {
+			test.ReportTestFailure(t, list, 500)
+		}
+
+		if peeked.Load() != 500 {
+			test.ReportTestFailure(t, peeked.Load(), 500)
+		}
+	})
+}
+
+func TestParallelStream_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the source is endless, so only cancelling the context can stop the stream
+	seen := 0
+	err := FromSeq(naturals).Parallel(ctx, 4, WithChunkSize(8)).ForEach(func(int) {
+		seen++
+		if seen == 100 {
+			cancel()
+		}
+	})
+
+	if err != context.Canceled {
+		test.ReportTestFailure(t, err, context.Canceled)
+	}
+
+	// no more chunks are passed on once the context is cancelled
+	if seen >= 100+8 {
+		test.ReportTestFailure(t, seen, "fewer than a chunk past the cancellation")
+	}
+
+	checkNoLeakedGoroutines(t, before)
+
+	t.Run("cancelled before starting", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		values, err := Of(1, 2, 3).Parallel(cancelled, 2).ToSlice()
+		if err != context.Canceled || values != nil {
+			test.ReportTestFailure(t, err, context.Canceled)
+		}
+	})
+
+	t.Run("no chunks start after cancelling", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// with one worker, the next chunk may already be waiting to be handed over when the first one cancels the stream
+		var started atomic.Int64
+		_, err := ParallelMap(FromSeq(naturals).Parallel(ctx, 1, WithChunkSize(1)), func(value int) int {
+			started.Add(1)
+			cancel()
+			return value
+		}).Count()
+
+		if err != context.Canceled || started.Load() != 1 {
+			test.ReportTestFailure(t, started.Load(), 1)
+		}
+	})
+
+	t.Run("cancelled after finishing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		count, err := Of(1, 2, 3).Parallel(ctx, 2).Count()
+		cancel()
+
+		if err != nil || count != 3 {
+			test.ReportTestFailure(t, err, nil)
+		}
+	})
+}
+
+func TestParallelStream_Panic(t *testing.T) {
+	type testScenario struct {
+		name   string
+		stream func() *ParallelStream[int]
+	}
+
+	testScenarios := []testScenario{
+		{
+			name: "panic in a stage",
+			stream: func() *ParallelStream[int] {
+				p := FromSeq(naturals).Parallel(context.Background(), 4, WithChunkSize(4))
+				return ParallelMap(p, func(value int) int {
+					if value == 50 {
+						panic("boom")
+					}
+					return value
+				})
+			},
+		},
+		{
+			name: "panic in the source",
+			stream: func() *ParallelStream[int] {
+				return FromSeq(naturals).Peek(func(value int) {
+					if value == 50 {
+						panic("boom")
+					}
+				}).Parallel(context.Background(), 4, WithChunkSize(4))
+			},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			func() {
+				defer func() {
+					r, ok := recover().(*PanicError)
+					if !ok || r.Value != "boom" {
+						test.ReportTestFailure(t, r, "boom")
+						return
+					}
+
+					// the stack is the one of the goroutine that panicked, which ran the function from this test
+					if !strings.Contains(string(r.Stack), "TestParallelStream_Panic") {
+						test.ReportTestFailure(t, string(r.Stack), "a stack through the panicking function")
+					}
+				}()
+
+				_, _ = ts.stream().Count()
+			}()
+
+			checkNoLeakedGoroutines(t, before)
+		})
+	}
+}
+
+func TestParallelStream_InvalidOptionsPanic(t *testing.T) {
+	type testScenario struct {
+		name string
+		op   func()
+	}
+
+	testScenarios := []testScenario{
+		{name: "no workers", op: func() { Of(1).Parallel(context.Background(), 0) }},
+		{name: "empty chunks", op: func() { Of(1).Parallel(context.Background(), 1, WithChunkSize(0)) }},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					test.ReportTestFailure(t, r, "a panic for an invalid option")
+				}
+			}()
+
+			ts.op()
+		})
+	}
+}
+
+func BenchmarkStream_Map(b *testing.B) {
+	ctx := context.Background()
+	values := make([]int, 10_000)
+	for i := range values {
+		values[i] = i
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(FromSlice(values), churn).Count()
+		}
+	})
+
+	for _, workers := range []int{2, 4, runtime.GOMAXPROCS(0)} {
+		b.Run("parallel/"+strconv.Itoa(workers)+" workers", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = ParallelMap(FromSlice(values).Parallel(ctx, workers), churn).Count()
+			}
+		})
+
+		b.Run("parallel ordered/"+strconv.Itoa(workers)+" workers", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = ParallelMap(FromSlice(values).Parallel(ctx, workers, WithEncounterOrder()), churn).Count()
+			}
+		})
+	}
+}
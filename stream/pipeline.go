@@ -0,0 +1,425 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/devsquared/gods/collection"
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// PipelineOption configures optional behaviour of a Pipeline.
+type PipelineOption func(*pipelineOptions)
+
+type pipelineOptions struct {
+	buffer     int
+	joinErrors bool
+}
+
+// WithJoinedErrors makes a Pipeline carry on past errors from fallible stages such as MapErr and FilterErr, dropping
+// the values that failed. The terminal operation then returns every error joined with errors.Join, along with the
+// result of the values that got through. Panics and context cancellation still stop the pipeline.
+func WithJoinedErrors() PipelineOption {
+	return func(options *pipelineOptions) {
+		options.joinErrors = true
+	}
+}
+
+// ErrStagePanicked is returned, wrapped with the recovered value, by the terminal operation of a Pipeline when one of
+// its stages panicked.
+var ErrStagePanicked = errors.New("stream: pipeline stage panicked")
+
+// Pipeline is a Stream whose every operation runs in its own goroutine, with the stages connected by channels. Each
+// channel holds at most the buffer size given when the Pipeline was created, so a slow stage holds back the stages
+// before it rather than letting values pile up in memory. Nothing starts until a terminal operation runs, and every
+// goroutine a terminal operation starts has stopped by the time it returns.
+//
+// Cancelling the context stops every stage, and the terminal operation returns the context's error. A panic in any
+// stage stops the rest of the pipeline in the same way and is returned from the terminal operation as an error wrapping
+// ErrStagePanicked, since it happened on another goroutine. The first error from a fallible stage stops the pipeline
+// the same way, unless the Pipeline was created WithJoinedErrors.
+type Pipeline[T any] struct {
+	ctx     context.Context
+	options pipelineOptions
+	start   func(state *pipelineState) <-chan T // starts the goroutines of every stage so far, returning the last channel
+}
+
+// pipelineState is shared by every stage of one run of a Pipeline.
+type pipelineState struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	options     pipelineOptions
+	wg          sync.WaitGroup
+	interrupted atomic.Bool // whether a stage stopped before its input ran out
+
+	mu   sync.Mutex
+	errs []error // the first error, or every error when errors are joined
+}
+
+// Pipeline returns a Pipeline that reads the Stream on its own goroutine and runs every later operation on another,
+// with channels holding up to buffer values between them. It panics when buffer is negative.
+func (s *Stream[T]) Pipeline(ctx context.Context, buffer int, options ...PipelineOption) *Pipeline[T] {
+	return &Pipeline[T]{
+		ctx:     ctx,
+		options: newPipelineOptions(buffer, options),
+		start: func(state *pipelineState) <-chan T {
+			return source(state, s.seq)
+		},
+	}
+}
+
+// PipelineFromChan returns a Pipeline over the values received from a channel until it is closed. Unlike a Pipeline
+// made from FromChan, it stops waiting on the channel as soon as the context is cancelled, even when no value arrives.
+// It panics when buffer is negative.
+func PipelineFromChan[T any](ctx context.Context, ch <-chan T, buffer int, options ...PipelineOption) *Pipeline[T] {
+	return &Pipeline[T]{
+		ctx:     ctx,
+		options: newPipelineOptions(buffer, options),
+		start: func(state *pipelineState) <-chan T {
+			return stage(state, ch, func(value T, send func(T) bool) bool {
+				return send(value)
+			})
+		},
+	}
+}
+
+// newPipelineOptions applies the options on top of the buffer size, panicking when it is negative.
+func newPipelineOptions(buffer int, options []PipelineOption) pipelineOptions {
+	if buffer < 0 {
+		panic("stream: pipeline buffer must not be negative")
+	}
+
+	applied := pipelineOptions{
+		buffer: buffer,
+	}
+	for _, option := range options {
+		option(&applied)
+	}
+
+	return applied
+}
+
+// ToChan starts a Pipeline over the Stream and returns the channel its values are sent on, which is closed once the
+// stream runs out or ctx is cancelled, along with a wait function that must always be called. It is shorthand for
+// calling ToChan on the Stream's Pipeline.
+func (s *Stream[T]) ToChan(ctx context.Context, buffer int) (<-chan T, func() error) {
+	return s.Pipeline(ctx, buffer).ToChan()
+}
+
+// Filter returns a Pipeline with a stage that only passes on the values keep reports true for.
+func (p *Pipeline[T]) Filter(keep func(value T) bool) *Pipeline[T] {
+	return withPipelineStage(p, func(value T, send func(T) bool) bool {
+		return !keep(value) || send(value)
+	})
+}
+
+// FilterErr returns a Pipeline with a stage that only passes on the values keep reports true for. An error from keep
+// stops the pipeline and is returned from the terminal operation, unless errors are joined, in which case the value is
+// dropped and the pipeline carries on.
+func (p *Pipeline[T]) FilterErr(keep func(value T) (bool, error)) *Pipeline[T] {
+	return withFallibleStage(p, func(value T, send func(T) bool) (bool, error) {
+		kept, err := keep(value)
+		if err != nil {
+			return true, err
+		}
+
+		return !kept || send(value), nil
+	})
+}
+
+// Peek returns a Pipeline with a stage that calls fn with each value as it passes through.
+func (p *Pipeline[T]) Peek(fn func(value T)) *Pipeline[T] {
+	return withPipelineStage(p, func(value T, send func(T) bool) bool {
+		fn(value)
+		return send(value)
+	})
+}
+
+// ForEach runs the pipeline, calling fn on the calling goroutine with each value that comes out of it. It returns the
+// first error from any stage, or every error joined when errors are joined, or the context's error when the context is
+// cancelled first.
+func (p *Pipeline[T]) ForEach(fn func(value T)) error {
+	return p.run(func(out <-chan T) {
+		for value := range out {
+			fn(value)
+		}
+	})
+}
+
+// Count runs the pipeline and returns the number of values that came out of it, or the error that stopped it.
+func (p *Pipeline[T]) Count() (int, error) {
+	count := 0
+	err := p.ForEach(func(T) {
+		count++
+	})
+	if err != nil && !p.options.joinErrors {
+		return 0, err
+	}
+
+	return count, err
+}
+
+// ToSlice runs the pipeline and returns its values in order in a new slice, or the error that stopped it.
+func (p *Pipeline[T]) ToSlice() ([]T, error) {
+	values := make([]T, 0)
+	err := p.ForEach(func(value T) {
+		values = append(values, value)
+	})
+	if err != nil && !p.options.joinErrors {
+		return nil, err
+	}
+
+	return values, err
+}
+
+// ToList runs the pipeline and returns its values in order in a new List, or the error that stopped it.
+func (p *Pipeline[T]) ToList() (*collection.List[T], error) {
+	values, err := p.ToSlice()
+	if err != nil && !p.options.joinErrors {
+		return nil, err
+	}
+
+	return collection.NewListFromSlice(values), err
+}
+
+// ToChan starts the pipeline and returns the channel its values come out on, along with a wait function. The channel
+// is closed once the pipeline finishes or stops, and is closed straight away when the context is already cancelled.
+// Wait must always be called, after reading from the channel is done: it blocks until every stage has stopped and
+// returns the error that stopped the pipeline, if any. A reader that stops reading before the channel is closed must
+// cancel the context first, or the stages are left blocked sending to it.
+func (p *Pipeline[T]) ToChan() (<-chan T, func() error) {
+	if err := p.ctx.Err(); err != nil {
+		out := make(chan T)
+		close(out)
+
+		return out, func() error { return err }
+	}
+
+	state := p.newState()
+	out := p.start(state)
+
+	// release the run's context as soon as the last stage stops, whether or not wait is ever called
+	done := make(chan struct{})
+	go func() {
+		state.wg.Wait()
+		state.cancel()
+		close(done)
+	}()
+
+	wait := func() error {
+		<-done
+		return state.result(p.ctx)
+	}
+
+	return out, wait
+}
+
+// newState creates the shared state for a run of the pipeline, with a context that is cancelled when any stage fails.
+func (p *Pipeline[T]) newState() *pipelineState {
+	ctx, cancel := context.WithCancel(p.ctx)
+
+	return &pipelineState{
+		ctx:     ctx,
+		cancel:  cancel,
+		options: p.options,
+	}
+}
+
+// run starts the pipeline, hands its last channel to consume, and waits for every stage to stop.
+func (p *Pipeline[T]) run(consume func(out <-chan T)) error {
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+
+	state := p.newState()
+	defer state.cancel()
+
+	consume(p.start(state))
+
+	state.cancel()
+	state.wg.Wait()
+
+	return state.result(p.ctx)
+}
+
+// fail records the error and stops every stage.
+func (s *pipelineState) fail(err error) {
+	s.mu.Lock()
+	if len(s.errs) == 0 || s.options.joinErrors {
+		s.errs = append(s.errs, err)
+	}
+	s.mu.Unlock()
+
+	s.cancel()
+}
+
+// report handles an error from a fallible stage, reporting whether the stage should carry on. Joined errors are
+// recorded and passed over; otherwise the error stops the pipeline.
+func (s *pipelineState) report(err error) bool {
+	if !s.options.joinErrors {
+		s.fail(err)
+		return false
+	}
+
+	s.mu.Lock()
+	s.errs = append(s.errs, err)
+	s.mu.Unlock()
+
+	return true
+}
+
+// recover turns a panic in a stage into an error that stops the pipeline. It must be deferred by the stage goroutine.
+func (s *pipelineState) recover() {
+	if r := recover(); r != nil {
+		s.fail(fmt.Errorf("%w: %v", ErrStagePanicked, r))
+	}
+}
+
+// result returns the error from the pipeline once every stage is done: the first stage error, or the error of the
+// parent context when a stage was cut short by it. When errors are joined, it joins all of them instead.
+func (s *pipelineState) result(parent context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	errs := s.errs
+	if s.interrupted.Load() && parent.Err() != nil {
+		errs = append(errs, parent.Err())
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if s.options.joinErrors {
+		return errors.Join(errs...)
+	}
+
+	return errs[0]
+}
+
+// send passes value to out, reporting false instead when the pipeline is stopped first.
+func send[T any](state *pipelineState, out chan<- T, value T) bool {
+	select {
+	case out <- value:
+		return true
+	case <-state.ctx.Done():
+		state.interrupted.Store(true)
+		return false
+	}
+}
+
+// source starts a goroutine that sends every value of seq on the returned channel.
+func source[T any](state *pipelineState, seq iter.Seq[T]) <-chan T {
+	out := make(chan T, state.options.buffer)
+
+	state.wg.Add(1)
+	go func() {
+		defer state.wg.Done()
+		defer close(out)
+		defer state.recover()
+
+		for value := range seq {
+			if !send(state, out, value) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// stage starts a goroutine that calls process with each value received from in, along with a function to send values
+// on the returned channel. The goroutine stops once in is closed, the pipeline is stopped or process returns false.
+func stage[T, U any](state *pipelineState, in <-chan T, process func(value T, send func(U) bool) bool) <-chan U {
+	out := make(chan U, state.options.buffer)
+	sendOut := func(value U) bool {
+		return send(state, out, value)
+	}
+
+	state.wg.Add(1)
+	go func() {
+		defer state.wg.Done()
+		defer close(out)
+		defer state.recover()
+
+		for {
+			select {
+			case value, ok := <-in:
+				if !ok || !process(value, sendOut) {
+					return
+				}
+			case <-state.ctx.Done():
+				state.interrupted.Store(true)
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// withPipelineStage returns a Pipeline that runs process in a new stage after the stages already in p.
+func withPipelineStage[T, U any](p *Pipeline[T], process func(value T, send func(U) bool) bool) *Pipeline[U] {
+	return &Pipeline[U]{
+		ctx:     p.ctx,
+		options: p.options,
+		start: func(state *pipelineState) <-chan U {
+			return stage(state, p.start(state), process)
+		},
+	}
+}
+
+// withFallibleStage returns a Pipeline that runs process in a new stage after the stages already in p, handing any
+// error from process to the pipeline.
+func withFallibleStage[T, U any](p *Pipeline[T], process func(value T, send func(U) bool) (bool, error)) *Pipeline[U] {
+	return &Pipeline[U]{
+		ctx:     p.ctx,
+		options: p.options,
+		start: func(state *pipelineState) <-chan U {
+			return stage(state, p.start(state), func(value T, send func(U) bool) bool {
+				carryOn, err := process(value, send)
+				if err != nil {
+					return state.report(err)
+				}
+
+				return carryOn
+			})
+		},
+	}
+}
+
+// PipeMap returns a Pipeline with a stage that passes on the result of fn for each value.
+func PipeMap[T, U any](p *Pipeline[T], fn func(value T) U) *Pipeline[U] {
+	return withPipelineStage(p, func(value T, send func(U) bool) bool {
+		return send(fn(value))
+	})
+}
+
+// MapErr returns a Pipeline with a stage that passes on the result of fn for each value. An error from fn stops the
+// pipeline and is returned from the terminal operation, unless errors are joined, in which case the value is dropped
+// and the pipeline carries on.
+func MapErr[T, U any](p *Pipeline[T], fn func(value T) (U, error)) *Pipeline[U] {
+	return withFallibleStage(p, func(value T, send func(U) bool) (bool, error) {
+		result, err := fn(value)
+		if err != nil {
+			return true, err
+		}
+
+		return send(result), nil
+	})
+}
+
+// TryCollect runs the pipeline and folds its values into a result with the collector, or returns the error that stopped
+// it. It is the fallible form of Collect for pipelines, whose stages can fail.
+func TryCollect[T, R any](p *Pipeline[T], collector Collector[T, R]) (R, error) {
+	add, finish := collector.start()
+	err := p.ForEach(add)
+	if err != nil && !p.options.joinErrors {
+		var zero R
+		return zero, err
+	}
+
+	return finish(), err
+}
@@ -0,0 +1,394 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPipeline_Results(t *testing.T) {
+	type testScenario struct {
+		name           string
+		pipeline       *Pipeline[string]
+		expectedValues []string
+	}
+
+	ctx := context.Background()
+	testScenarios := []testScenario{
+		{
+			name:           "pipeline over nothing",
+			pipeline:       PipeMap(Of[int]().Pipeline(ctx, 1), strconv.Itoa),
+			expectedValues: []string{},
+		},
+		{
+			name:           "unbuffered pipeline",
+			pipeline:       PipeMap(Of(1, 2, 3).Pipeline(ctx, 0), strconv.Itoa),
+			expectedValues: []string{"1", "2", "3"},
+		},
+		{
+			name:           "stages keep their order",
+			pipeline:       PipeMap(FromSeq(naturals).Limit(10).Pipeline(ctx, 2).Filter(isEven), strconv.Itoa),
+			expectedValues: []string{"2", "4", "6", "8", "10"},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualValues, err := ts.pipeline.ToSlice()
+			if err != nil || !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+
+	t.Run("pipeline from a channel", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		actualValues, err := PipelineFromChan(ctx, ch, 1).Peek(func(int) {}).ToList()
+		if err != nil || !gocmp.Equal(listValues(actualValues), []int{1, 2, 3}) {
+			test.ReportTestFailure(t, actualValues, []int{1, 2, 3})
+		}
+	})
+
+	t.Run("collect a pipeline", func(t *testing.T) {
+		actualSum, err := TryCollect(Of(1, 2, 3, 4).Pipeline(ctx, 1), Summarizing(func(value int) int { return value }))
+		if err != nil || actualSum.Sum != 10 {
+			test.ReportTestFailure(t, actualSum, 10)
+		}
+	})
+}
+
+func TestPipeline_Backpressure(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	var read atomic.Int64
+	release := make(chan struct{})
+
+	s := FromSeq(naturals).Limit(100).Peek(func(int) { read.Add(1) })
+	done := make(chan error)
+	go func() {
+		done <- s.Pipeline(context.Background(), 1).Peek(func(int) {}).ForEach(func(value int) {
+			if value == 1 {
+				<-release
+			}
+		})
+	}()
+
+	// with the reader held up on the first value, each stage can only get as far ahead as its channel holds
+	time.Sleep(20 * time.Millisecond)
+	if actualRead := read.Load(); actualRead > 5 {
+		test.ReportTestFailure(t, actualRead, "no more than a value per stage and channel ahead of the reader")
+	}
+
+	close(release)
+	if err := <-done; err != nil || read.Load() != 100 {
+		test.ReportTestFailure(t, read.Load(), 100)
+	}
+
+	checkNoLeakedGoroutines(t, before)
+}
+
+func TestPipeline_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the source is endless, so only cancelling the context can stop the pipeline
+	seen := 0
+	err := PipeMap(FromSeq(naturals).Pipeline(ctx, 4), churn).ForEach(func(int) {
+		seen++
+		if seen == 100 {
+			cancel()
+		}
+	})
+
+	if err != context.Canceled {
+		test.ReportTestFailure(t, err, context.Canceled)
+	}
+
+	checkNoLeakedGoroutines(t, before)
+
+	t.Run("cancel while waiting on a channel", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// nothing is ever sent, so the source stage is waiting on the channel when the context ends
+		count, err := PipelineFromChan(ctx, make(chan int), 0).Count()
+		if err != context.DeadlineExceeded || count != 0 {
+			test.ReportTestFailure(t, err, context.DeadlineExceeded)
+		}
+
+		checkNoLeakedGoroutines(t, before)
+	})
+
+	t.Run("cancelled before starting", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		values, err := Of(1, 2, 3).Pipeline(cancelled, 1).ToSlice()
+		if err != context.Canceled || values != nil {
+			test.ReportTestFailure(t, err, context.Canceled)
+		}
+	})
+
+	t.Run("cancelled after finishing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		count, err := Of(1, 2, 3).Pipeline(ctx, 1).Count()
+		cancel()
+
+		if err != nil || count != 3 {
+			test.ReportTestFailure(t, err, nil)
+		}
+	})
+}
+
+func TestPipeline_Panic(t *testing.T) {
+	type testScenario struct {
+		name     string
+		pipeline func() *Pipeline[int]
+	}
+
+	testScenarios := []testScenario{
+		{
+			name: "panic in a stage",
+			pipeline: func() *Pipeline[int] {
+				return PipeMap(FromSeq(naturals).Pipeline(context.Background(), 2), func(value int) int {
+					if value == 50 {
+						panic("boom")
+					}
+					return value
+				})
+			},
+		},
+		{
+			name: "panic in the source",
+			pipeline: func() *Pipeline[int] {
+				return FromSeq(naturals).Peek(func(value int) {
+					if value == 50 {
+						panic("boom")
+					}
+				}).Pipeline(context.Background(), 2).Filter(isEven)
+			},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			count, err := ts.pipeline().Count()
+			if !errors.Is(err, ErrStagePanicked) || count != 0 {
+				test.ReportTestFailure(t, err, ErrStagePanicked)
+			}
+
+			checkNoLeakedGoroutines(t, before)
+		})
+	}
+}
+
+func TestPipeline_Errors(t *testing.T) {
+	errOdd := errors.New("odd")
+	evenOnly := func(value int) (bool, error) {
+		if !isEven(value) {
+			return false, errOdd
+		}
+		return true, nil
+	}
+
+	type testScenario struct {
+		name           string
+		pipeline       func(options ...PipelineOption) *Pipeline[int]
+		expectedValues []int
+		expectedErrs   []error
+	}
+
+	testScenarios := []testScenario{
+		{
+			name: "map without errors",
+			pipeline: func(options ...PipelineOption) *Pipeline[int] {
+				return MapErr(FromSlice([]string{"1", "2", "3"}).Pipeline(context.Background(), 1, options...), strconv.Atoi)
+			},
+			expectedValues: []int{1, 2, 3},
+		},
+		{
+			name: "map with errors",
+			pipeline: func(options ...PipelineOption) *Pipeline[int] {
+				return MapErr(FromSlice([]string{"1", "a", "3", "b"}).Pipeline(context.Background(), 1, options...), strconv.Atoi)
+			},
+			expectedValues: []int{1, 3},
+			expectedErrs:   []error{strconv.ErrSyntax, strconv.ErrSyntax},
+		},
+		{
+			name: "filter with errors",
+			pipeline: func(options ...PipelineOption) *Pipeline[int] {
+				return Of(2, 3, 4, 5).Pipeline(context.Background(), 1, options...).FilterErr(evenOnly)
+			},
+			expectedValues: []int{2, 4},
+			expectedErrs:   []error{errOdd, errOdd},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			actualValues, err := ts.pipeline().ToSlice()
+			if len(ts.expectedErrs) == 0 {
+				if err != nil || !gocmp.Equal(actualValues, ts.expectedValues) {
+					test.ReportTestFailure(t, actualValues, ts.expectedValues)
+				}
+			} else if !errors.Is(err, ts.expectedErrs[0]) || actualValues != nil {
+				test.ReportTestFailure(t, err, ts.expectedErrs[0])
+			}
+
+			checkNoLeakedGoroutines(t, before)
+		})
+
+		t.Run(ts.name+" joined", func(t *testing.T) {
+			actualValues, err := ts.pipeline(WithJoinedErrors()).ToSlice()
+			if !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+
+			if len(ts.expectedErrs) == 0 {
+				if err != nil {
+					test.ReportTestFailure(t, err, nil)
+				}
+				return
+			}
+
+			joined, ok := err.(interface{ Unwrap() []error })
+			if !ok || len(joined.Unwrap()) != len(ts.expectedErrs) {
+				test.ReportTestFailure(t, err, ts.expectedErrs)
+				return
+			}
+			for i, actualErr := range joined.Unwrap() {
+				if !errors.Is(actualErr, ts.expectedErrs[i]) {
+					test.ReportTestFailure(t, actualErr, ts.expectedErrs[i])
+				}
+			}
+		})
+	}
+
+	t.Run("first error stops an endless pipeline", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		count, err := FromSeq(naturals).Pipeline(context.Background(), 4).FilterErr(func(value int) (bool, error) {
+			if value == 50 {
+				return false, errOdd
+			}
+			return true, nil
+		}).Count()
+
+		if err != errOdd || count != 0 {
+			test.ReportTestFailure(t, err, errOdd)
+		}
+
+		checkNoLeakedGoroutines(t, before)
+	})
+
+	t.Run("collect with an error", func(t *testing.T) {
+		values := FromSlice([]string{"1", "x"}).Pipeline(context.Background(), 0)
+		if _, err := TryCollect(MapErr(values, strconv.Atoi), ToSet[int]()); !errors.Is(err, strconv.ErrSyntax) {
+			test.ReportTestFailure(t, err, strconv.ErrSyntax)
+		}
+	})
+}
+
+func TestPipeline_ToChan(t *testing.T) {
+	t.Run("read every value", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		out, wait := FromSeq(naturals).Limit(5).ToChan(context.Background(), 1)
+
+		actualValues := FromChan(out).ToSlice()
+		if err := wait(); err != nil || !gocmp.Equal(actualValues, []int{1, 2, 3, 4, 5}) {
+			test.ReportTestFailure(t, actualValues, []int{1, 2, 3, 4, 5})
+		}
+
+		checkNoLeakedGoroutines(t, before)
+	})
+
+	t.Run("stop reading early", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		out, wait := FromSeq(naturals).Pipeline(ctx, 1).Filter(isEven).ToChan()
+		if first, ok := FromChan(out).FindFirst(); !ok || first != 2 {
+			test.ReportTestFailure(t, first, 2)
+		}
+
+		cancel()
+		if err := wait(); err != context.Canceled {
+			test.ReportTestFailure(t, err, context.Canceled)
+		}
+
+		checkNoLeakedGoroutines(t, before)
+	})
+
+	t.Run("drain without waiting", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		// the run's context is released once the last stage stops, even though wait is never called
+		out, _ := Of(1, 2, 3).ToChan(context.Background(), 0)
+		if count := FromChan(out).Count(); count != 3 {
+			test.ReportTestFailure(t, count, 3)
+		}
+
+		checkNoLeakedGoroutines(t, before)
+	})
+
+	t.Run("cancelled before starting", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		out, wait := Of(1, 2, 3).ToChan(cancelled, 1)
+		if count := FromChan(out).Count(); count != 0 {
+			test.ReportTestFailure(t, count, 0)
+		}
+
+		if err := wait(); err != context.Canceled {
+			test.ReportTestFailure(t, err, context.Canceled)
+		}
+	})
+}
+
+func TestPipeline_InvalidBufferPanics(t *testing.T) {
+	type testScenario struct {
+		name string
+		op   func()
+	}
+
+	testScenarios := []testScenario{
+		{name: "pipeline with a negative buffer", op: func() { Of(1).Pipeline(context.Background(), -1) }},
+		{name: "channel pipeline with a negative buffer", op: func() { PipelineFromChan(context.Background(), make(chan int), -1) }},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					test.ReportTestFailure(t, r, "a panic for an invalid buffer")
+				}
+			}()
+
+			ts.op()
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Package stream provides lazy pipelines over sequences of values. A Stream is created from a source, such as a slice,
+// a List, a channel or any collection with an All iterator, and is shaped by intermediate operations like Filter or
+// Limit. Intermediate operations only describe the pipeline; nothing runs until a terminal operation like ForEach,
+// Count or ToList pulls values through it, and each value flows through every stage before the next one is read.
+//
+// Operations that change the type of the values, such as Map, or that need comparable values, such as Distinct, are
+// functions rather than methods, since Go methods cannot introduce type parameters of their own.
+package stream
+
+import (
+	"github.com/devsquared/gods/collection"
+	"iter"
+	"slices"
+)
+
+// ensure Stream satisfies the Iterable interface at compile time
+var _ collection.Iterable[any] = (*Stream[any])(nil)
+
+// Stream is a lazy pipeline of values of type T. A Stream built on a source that can be iterated more than once, such
+// as a slice or a collection, may be consumed by more than one terminal operation, with each one running the whole
+// pipeline again. A Stream built on a channel can only be consumed once.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// FromSeq constructs a Stream over the values of an iterator.
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{
+		seq: seq,
+	}
+}
+
+// From constructs a Stream over the values of any collection that can be iterated, visiting them in the order the
+// collection's All method gives.
+func From[T any](iterable collection.Iterable[T]) *Stream[T] {
+	return FromSeq(iterable.All())
+}
+
+// Of constructs a Stream over the given values in order.
+func Of[T any](values ...T) *Stream[T] {
+	return FromSlice(values)
+}
+
+// FromSlice constructs a Stream over the values of a slice in order. The slice is read lazily, so changes made to it
+// before the terminal operation runs are seen by the stream.
+func FromSlice[T any](slice []T) *Stream[T] {
+	return FromSeq(slices.Values(slice))
+}
+
+// FromList constructs a Stream over the values of a List in order.
+func FromList[T any](list *collection.List[T]) *Stream[T] {
+	return FromSeq(list.All())
+}
+
+// FromChan constructs a Stream over the values received from a channel until it is closed. Receiving happens as the
+// terminal operation pulls values, so a terminal operation that stops early, such as FindFirst, leaves the remaining
+// values in the channel. Use PipelineFromChan instead when waiting on the channel needs to stop with a context.
+func FromChan[T any](ch <-chan T) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range ch {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// All returns an iterator over the values of the Stream, which runs the pipeline as it is iterated. This lets a
+// Stream be used in a for range loop or as the source of another Stream.
+func (s *Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter returns a Stream of only the values that keep reports true for.
+func (s *Stream[T]) Filter(keep func(value T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if keep(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Peek returns a Stream of the same values that calls fn with each value as it passes through. It is mostly useful for
+// debugging, to see the values flowing past a point in the pipeline.
+func (s *Stream[T]) Peek(fn func(value T)) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			fn(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted returns a Stream of the values in the order given by compare, which returns a negative number when a sorts
+// before b, a positive number when a sorts after b and zero when they are equal. Equal values keep their order. Unlike
+// other intermediate operations, Sorted has to read every value before passing the first one on, so it must not be
+// used on an endless stream.
+func (s *Stream[T]) Sorted(compare func(a, b T) int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		values := slices.Collect(s.seq)
+		slices.SortStableFunc(values, compare)
+
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Limit returns a Stream of at most the first n values. No more than n values are read from the source, which makes
+// Limit the way to bound an endless stream. It panics when n is negative.
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	if n < 0 {
+		panic("stream: limit must not be negative")
+	}
+
+	return FromSeq(func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+
+		count := 0
+		for value := range s.seq {
+			count++
+			if !yield(value) || count == n {
+				return
+			}
+		}
+	})
+}
+
+// Skip returns a Stream of the values after the first n. It panics when n is negative.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	if n < 0 {
+		panic("stream: skip must not be negative")
+	}
+
+	return FromSeq(func(yield func(T) bool) {
+		skipped := 0
+		for value := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile returns a Stream of the values up to, but not including, the first one that keep reports false for. No
+// values are read from the source after that one.
+func (s *Stream[T]) TakeWhile(keep func(value T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if !keep(value) || !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile returns a Stream of the values from the first one that drop reports false for onwards. Once a value is
+// kept, every value after it is kept too.
+func (s *Stream[T]) DropWhile(drop func(value T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		dropping := true
+		for value := range s.seq {
+			if dropping && drop(value) {
+				continue
+			}
+
+			dropping = false
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Map returns a Stream of the result of fn for each value of the Stream, in order.
+func Map[T, U any](s *Stream[T], fn func(value T) U) *Stream[U] {
+	return FromSeq(func(yield func(U) bool) {
+		for value := range s.seq {
+			if !yield(fn(value)) {
+				return
+			}
+		}
+	})
+}
+
+// FlatMap returns a Stream of every value of the iterators that fn returns for each value of the Stream, in order.
+// Any collection's All method, or the All method of another Stream, gives a suitable iterator.
+func FlatMap[T, U any](s *Stream[T], fn func(value T) iter.Seq[U]) *Stream[U] {
+	return FromSeq(func(yield func(U) bool) {
+		for value := range s.seq {
+			for mapped := range fn(value) {
+				if !yield(mapped) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Distinct returns a Stream that drops any value equal to one before it, keeping the first of each in order. It holds
+// every distinct value it has seen in memory.
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for value := range s.seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package stream
+
+import (
+	"cmp"
+	"github.com/devsquared/gods/collection"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// naturals is an endless sequence of the natural numbers from 1 upwards.
+func naturals(yield func(int) bool) {
+	for i := 1; ; i++ {
+		if !yield(i) {
+			return
+		}
+	}
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestStream_Sources(t *testing.T) {
+	type testScenario struct {
+		name           string
+		stream         *Stream[int]
+		expectedValues []int
+	}
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	testScenarios := []testScenario{
+		{name: "from values", stream: Of(1, 2, 3), expectedValues: []int{1, 2, 3}},
+		{name: "from no values", stream: Of[int](), expectedValues: []int{}},
+		{name: "from slice", stream: FromSlice([]int{1, 2, 3}), expectedValues: []int{1, 2, 3}},
+		{name: "from list", stream: FromList(collection.NewListFromSlice([]int{1, 2, 3})), expectedValues: []int{1, 2, 3}},
+		{name: "from channel", stream: FromChan(ch), expectedValues: []int{1, 2, 3}},
+		{name: "from iterator", stream: FromSeq(naturals).Limit(3), expectedValues: []int{1, 2, 3}},
+		{
+			name:           "from any iterable collection",
+			stream:         From[int](collection.NewListFromSlice([]int{1, 2, 3})),
+			expectedValues: []int{1, 2, 3},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualValues := ts.stream.ToSlice(); !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+}
+
+func TestStream_Intermediate(t *testing.T) {
+	type testScenario struct {
+		name           string
+		stream         *Stream[int]
+		expectedValues []int
+	}
+
+	values := []int{5, 3, 8, 1, 8, 2, 3, 6}
+
+	testScenarios := []testScenario{
+		{name: "filter", stream: FromSlice(values).Filter(isEven), expectedValues: []int{8, 8, 2, 6}},
+		{name: "sorted", stream: FromSlice(values).Sorted(cmp.Compare[int]), expectedValues: []int{1, 2, 3, 3, 5, 6, 8, 8}},
+		{name: "limit", stream: FromSlice(values).Limit(3), expectedValues: []int{5, 3, 8}},
+		{name: "limit to nothing", stream: FromSlice(values).Limit(0), expectedValues: []int{}},
+		{name: "limit past the end", stream: FromSlice(values).Limit(20), expectedValues: values},
+		{name: "skip", stream: FromSlice(values).Skip(5), expectedValues: []int{2, 3, 6}},
+		{name: "skip past the end", stream: FromSlice(values).Skip(20), expectedValues: []int{}},
+		{
+			name:           "take while",
+			stream:         FromSlice(values).TakeWhile(func(value int) bool { return value > 2 }),
+			expectedValues: []int{5, 3, 8},
+		},
+		{
+			name:           "drop while",
+			stream:         FromSlice(values).DropWhile(func(value int) bool { return value > 2 }),
+			expectedValues: []int{1, 8, 2, 3, 6},
+		},
+		{name: "distinct", stream: Distinct(FromSlice(values)), expectedValues: []int{5, 3, 8, 1, 2, 6}},
+		{
+			name:           "map",
+			stream:         Map(FromSlice(values), func(value int) int { return value * 10 }),
+			expectedValues: []int{50, 30, 80, 10, 80, 20, 30, 60},
+		},
+		{
+			name: "flat map",
+			stream: FlatMap(Of(1, 2, 3), func(value int) iter.Seq[int] {
+				return slices.Values(slices.Repeat([]int{value}, value))
+			}),
+			expectedValues: []int{1, 2, 2, 3, 3, 3},
+		},
+		{
+			name:           "chained operations",
+			stream:         Distinct(FromSlice(values).Filter(isEven)).Sorted(cmp.Compare[int]).Skip(1),
+			expectedValues: []int{6, 8},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualValues := ts.stream.ToSlice(); !gocmp.Equal(actualValues, ts.expectedValues) {
+				test.ReportTestFailure(t, actualValues, ts.expectedValues)
+			}
+		})
+	}
+
+	t.Run("map to another type", func(t *testing.T) {
+		actualValues := Map(Of("go", "data", "structures"), strings.ToUpper).ToSlice()
+
+		expectedValues := []string{"GO", "DATA", "STRUCTURES"}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("sorted keeps equal values in order", func(t *testing.T) {
+		words := Of("bb", "a", "cc", "d", "aa")
+		byLength := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+
+		actualValues := words.Sorted(byLength).ToSlice()
+		expectedValues := []string{"a", "d", "bb", "cc", "aa"}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+}
+
+func TestStream_NegativeCountPanics(t *testing.T) {
+	type testScenario struct {
+		name string
+		op   func(s *Stream[int])
+	}
+
+	testScenarios := []testScenario{
+		{name: "limit", op: func(s *Stream[int]) { s.Limit(-1) }},
+		{name: "skip", op: func(s *Stream[int]) { s.Skip(-1) }},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					test.ReportTestFailure(t, r, "a panic for a negative count")
+				}
+			}()
+
+			ts.op(Of(1, 2, 3))
+		})
+	}
+}
+
+func TestStream_Lazy(t *testing.T) {
+	t.Run("nothing runs before a terminal operation", func(t *testing.T) {
+		visited := 0
+		s := Of(1, 2, 3).Peek(func(int) { visited++ }).Filter(isEven)
+
+		if visited != 0 {
+			test.ReportTestFailure(t, visited, 0)
+		}
+
+		if count := s.Count(); count != 1 {
+			test.ReportTestFailure(t, count, 1)
+		}
+
+		if visited != 3 {
+			test.ReportTestFailure(t, visited, 3)
+		}
+	})
+
+	t.Run("only the values needed are read", func(t *testing.T) {
+		read := make([]int, 0)
+		actualValues := FromSeq(naturals).
+			Peek(func(value int) { read = append(read, value) }).
+			Filter(isEven).
+			Limit(2).
+			ToSlice()
+
+		expectedValues := []int{2, 4}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+
+		// each value flows through every stage before the next is read, and limit stops reading once it is satisfied
+		expectedRead := []int{1, 2, 3, 4}
+		if !gocmp.Equal(read, expectedRead) {
+			test.ReportTestFailure(t, read, expectedRead)
+		}
+	})
+
+	t.Run("a stream over a collection can run more than once", func(t *testing.T) {
+		list := collection.NewListFromSlice([]int{1, 2, 3, 4})
+		evens := FromList(list).Filter(isEven)
+
+		if count := evens.Count(); count != 2 {
+			test.ReportTestFailure(t, count, 2)
+		}
+
+		list.Add(6)
+		actualValues := evens.ToSlice()
+		expectedValues := []int{2, 4, 6}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("range over a stream", func(t *testing.T) {
+		actualValues := make([]int, 0)
+		for value := range FromSeq(naturals).Filter(isEven).All() {
+			if value > 6 {
+				break
+			}
+			actualValues = append(actualValues, value)
+		}
+
+		expectedValues := []int{2, 4, 6}
+		if !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+}
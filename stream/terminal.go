@@ -0,0 +1,130 @@
+package stream
+
+import "github.com/devsquared/gods/collection"
+
+// ForEach runs the pipeline, calling fn with each value in order.
+func (s *Stream[T]) ForEach(fn func(value T)) {
+	for value := range s.seq {
+		fn(value)
+	}
+}
+
+// Count runs the pipeline and returns the number of values that came out of it.
+func (s *Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+
+	return count
+}
+
+// Min returns the least value according to compare, which orders values like the one given to Sorted. The returned
+// bool is false when the Stream is empty. When several values are equally least, the first of them is returned.
+func (s *Stream[T]) Min(compare func(a, b T) int) (T, bool) {
+	return s.best(func(value, best T) bool {
+		return compare(value, best) < 0
+	})
+}
+
+// Max returns the greatest value according to compare, which orders values like the one given to Sorted. The returned
+// bool is false when the Stream is empty. When several values are equally greatest, the first of them is returned.
+func (s *Stream[T]) Max(compare func(a, b T) int) (T, bool) {
+	return s.best(func(value, best T) bool {
+		return compare(value, best) > 0
+	})
+}
+
+// AnyMatch reports whether predicate is true for at least one value, reading no further than the first such value.
+// It is false for an empty Stream.
+func (s *Stream[T]) AnyMatch(predicate func(value T) bool) bool {
+	for value := range s.seq {
+		if predicate(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllMatch reports whether predicate is true for every value, reading no further than the first value it is false for.
+// It is true for an empty Stream.
+func (s *Stream[T]) AllMatch(predicate func(value T) bool) bool {
+	for value := range s.seq {
+		if !predicate(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NoneMatch reports whether predicate is false for every value, reading no further than the first value it is true
+// for. It is true for an empty Stream.
+func (s *Stream[T]) NoneMatch(predicate func(value T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// FindFirst returns the first value to come out of the pipeline without reading any further. The returned bool is
+// false when the Stream is empty.
+func (s *Stream[T]) FindFirst() (T, bool) {
+	for value := range s.seq {
+		return value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// ToSlice runs the pipeline and returns its values in order in a new slice.
+func (s *Stream[T]) ToSlice() []T {
+	values := make([]T, 0)
+	for value := range s.seq {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// ToList runs the pipeline and returns its values in order in a new List.
+func (s *Stream[T]) ToList() *collection.List[T] {
+	return collection.NewListFromSlice(s.ToSlice())
+}
+
+// best returns the first value that no later value is better than, along with whether there was any value at all.
+func (s *Stream[T]) best(better func(value, best T) bool) (T, bool) {
+	var best T
+	found := false
+	for value := range s.seq {
+		if !found || better(value, best) {
+			best = value
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// Reduce runs the pipeline, folding its values into a single result by starting from initial and calling fn with the
+// result so far and each value in order.
+func Reduce[T, A any](s *Stream[T], initial A, fn func(result A, value T) A) A {
+	result := initial
+	for value := range s.seq {
+		result = fn(result, value)
+	}
+
+	return result
+}
+
+// CollectSet runs the pipeline and returns its values in a new Set, which only keeps one of any repeated value. It is
+// shorthand for collecting with ToSet.
+func CollectSet[T comparable](s *Stream[T]) *collection.Set[T] {
+	return Collect(s, ToSet[T]())
+}
+
+// CollectMap runs the pipeline and returns a new map holding the key and value that key and value give for each value
+// of the Stream. When several values give the same key, the last of them wins. It is shorthand for collecting with
+// ToMap without a merge function.
+func CollectMap[T any, K comparable, V any](s *Stream[T], key func(value T) K, value func(value T) V) map[K]V {
+	return Collect(s, ToMap(key, value, nil))
+}
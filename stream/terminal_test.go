@@ -0,0 +1,200 @@
+package stream
+
+import (
+	"cmp"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestStream_ForEach(t *testing.T) {
+	actualValues := make([]int, 0)
+	Of(1, 2, 3).ForEach(func(value int) {
+		actualValues = append(actualValues, value)
+	})
+
+	expectedValues := []int{1, 2, 3}
+	if !gocmp.Equal(actualValues, expectedValues) {
+		test.ReportTestFailure(t, actualValues, expectedValues)
+	}
+}
+
+func TestStream_MinMax(t *testing.T) {
+	type testScenario struct {
+		name          string
+		stream        *Stream[int]
+		expectedMin   int
+		expectedMax   int
+		expectedFound bool
+	}
+
+	testScenarios := []testScenario{
+		{name: "empty stream", stream: Of[int](), expectedFound: false},
+		{name: "single value", stream: Of(4), expectedMin: 4, expectedMax: 4, expectedFound: true},
+		{name: "many values", stream: Of(5, 3, 8, 1, 9, 2), expectedMin: 1, expectedMax: 9, expectedFound: true},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualMin, foundMin := ts.stream.Min(cmp.Compare[int])
+			if actualMin != ts.expectedMin || foundMin != ts.expectedFound {
+				test.ReportTestFailure(t, actualMin, ts.expectedMin)
+			}
+
+			actualMax, foundMax := ts.stream.Max(cmp.Compare[int])
+			if actualMax != ts.expectedMax || foundMax != ts.expectedFound {
+				test.ReportTestFailure(t, actualMax, ts.expectedMax)
+			}
+		})
+	}
+
+	t.Run("first of equal values wins", func(t *testing.T) {
+		byLength := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+		words := Of("bb", "a", "cc", "d")
+
+		if actualMin, _ := words.Min(byLength); actualMin != "a" {
+			test.ReportTestFailure(t, actualMin, "a")
+		}
+
+		if actualMax, _ := words.Max(byLength); actualMax != "bb" {
+			test.ReportTestFailure(t, actualMax, "bb")
+		}
+	})
+}
+
+func TestStream_Match(t *testing.T) {
+	type testScenario struct {
+		name          string
+		values        []int
+		expectedAny   bool
+		expectedAll   bool
+		expectedNone  bool
+		expectedFirst int
+		expectedFound bool
+	}
+
+	testScenarios := []testScenario{
+		{name: "empty stream", values: []int{}, expectedAny: false, expectedAll: true, expectedNone: true},
+		{
+			name:          "no values match",
+			values:        []int{1, 3},
+			expectedAny:   false,
+			expectedAll:   false,
+			expectedNone:  true,
+			expectedFirst: 1,
+			expectedFound: true,
+		},
+		{
+			name:          "some values match",
+			values:        []int{1, 2},
+			expectedAny:   true,
+			expectedAll:   false,
+			expectedNone:  false,
+			expectedFirst: 1,
+			expectedFound: true,
+		},
+		{
+			name:          "every value matches",
+			values:        []int{2, 4},
+			expectedAny:   true,
+			expectedAll:   true,
+			expectedNone:  false,
+			expectedFirst: 2,
+			expectedFound: true,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			s := FromSlice(ts.values)
+
+			if actualAny := s.AnyMatch(isEven); actualAny != ts.expectedAny {
+				test.ReportTestFailure(t, actualAny, ts.expectedAny)
+			}
+
+			if actualAll := s.AllMatch(isEven); actualAll != ts.expectedAll {
+				test.ReportTestFailure(t, actualAll, ts.expectedAll)
+			}
+
+			if actualNone := s.NoneMatch(isEven); actualNone != ts.expectedNone {
+				test.ReportTestFailure(t, actualNone, ts.expectedNone)
+			}
+
+			actualFirst, found := s.FindFirst()
+			if actualFirst != ts.expectedFirst || found != ts.expectedFound {
+				test.ReportTestFailure(t, actualFirst, ts.expectedFirst)
+			}
+		})
+	}
+
+	t.Run("matching stops at the first answer on an endless stream", func(t *testing.T) {
+		if !FromSeq(naturals).AnyMatch(func(value int) bool { return value > 100 }) {
+			test.ReportTestFailure(t, false, true)
+		}
+
+		if FromSeq(naturals).AllMatch(func(value int) bool { return value < 100 }) {
+			test.ReportTestFailure(t, true, false)
+		}
+
+		if first, _ := FromSeq(naturals).Filter(isEven).FindFirst(); first != 2 {
+			test.ReportTestFailure(t, first, 2)
+		}
+	})
+}
+
+func TestStream_Reduce(t *testing.T) {
+	sum := Reduce(Of(1, 2, 3, 4), 0, func(result, value int) int {
+		return result + value
+	})
+
+	if sum != 10 {
+		test.ReportTestFailure(t, sum, 10)
+	}
+
+	joined := Reduce(Of(1, 2, 3), "", func(result string, value int) string {
+		return result + strconv.Itoa(value)
+	})
+
+	if joined != "123" {
+		test.ReportTestFailure(t, joined, "123")
+	}
+
+	if count := Of("a", "b", "c").Filter(func(value string) bool { return value != "b" }).Count(); count != 2 {
+		test.ReportTestFailure(t, count, 2)
+	}
+}
+
+func TestStream_Collect(t *testing.T) {
+	t.Run("collect into a list", func(t *testing.T) {
+		list := Of(3, 1, 2).ToList()
+
+		expectedValues := []int{3, 1, 2}
+		if actualValues := slices.Collect(list.All()); !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("collect into a set", func(t *testing.T) {
+		set := CollectSet(Of(3, 1, 3, 2, 1))
+
+		expectedValues := []int{1, 2, 3}
+		if actualValues := slices.Sorted(set.All()); !gocmp.Equal(actualValues, expectedValues) {
+			test.ReportTestFailure(t, actualValues, expectedValues)
+		}
+	})
+
+	t.Run("collect into a map", func(t *testing.T) {
+		byLength := CollectMap(Of("a", "bb", "cc", "ddd"),
+			func(value string) int { return len(value) },
+			func(value string) string { return value },
+		)
+
+		// the last value for a key wins
+		expected := map[int]string{1: "a", 2: "cc", 3: "ddd"}
+		if !gocmp.Equal(byLength, expected) {
+			test.ReportTestFailure(t, byLength, expected)
+		}
+	})
+}
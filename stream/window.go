@@ -0,0 +1,151 @@
+package stream
+
+import (
+	"github.com/devsquared/gods/queue"
+	"slices"
+	"time"
+)
+
+// Clock tells the current time. Window reads it as each value arrives, and tests can give Window a fake clock with
+// WithClock to control which window each value falls in.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to a Clock.
+type ClockFunc func() time.Time
+
+// Now returns the result of calling the function.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// WindowOption configures optional behaviour of Window.
+type WindowOption func(*windowOptions)
+
+type windowOptions struct {
+	clock Clock
+}
+
+// WithClock makes Window read the time from clock instead of the system clock.
+func WithClock(clock Clock) WindowOption {
+	return func(options *windowOptions) {
+		options.clock = clock
+	}
+}
+
+// Chunk returns a Stream of the values grouped into slices of n in order. The last slice holds whatever is left over,
+// so it may be shorter. It panics when n is below 1.
+func Chunk[T any](s *Stream[T], n int) *Stream[[]T] {
+	if n < 1 {
+		panic("stream: chunk size must be at least 1")
+	}
+
+	return FromSeq(func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for value := range s.seq {
+			chunk = append(chunk, value)
+			if len(chunk) < n {
+				continue
+			}
+
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, n)
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	})
+}
+
+// Sliding returns a Stream of windows of size consecutive values, where each window starts step values after the one
+// before it. Windows overlap when step is less than size and leave values out when step is greater. Only full windows
+// are passed on, so any values at the end that do not fill a window are dropped. Each window is a new slice. It panics
+// when size or step is below 1.
+func Sliding[T any](s *Stream[T], size, step int) *Stream[[]T] {
+	if size < 1 {
+		panic("stream: sliding window size must be at least 1")
+	}
+	if step < 1 {
+		panic("stream: sliding window step must be at least 1")
+	}
+
+	return FromSeq(func(yield func([]T) bool) {
+		window := queue.NewRingQueue[T]()
+		skip := 0 // values to leave out before the next window starts when step is greater than size
+
+		for value := range s.seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			window.Push(value)
+			if window.Length() < size {
+				continue
+			}
+
+			if !yield(slices.Collect(window.All())) {
+				return
+			}
+
+			for range min(step, size) {
+				_, _ = window.Pop()
+			}
+			skip = max(step-size, 0)
+		}
+	})
+}
+
+// Window returns a Stream of the values grouped by the time they arrive into back to back windows of the given
+// duration. The first window starts when the first value arrives, and later windows follow on from it, so a window
+// that passes without any values is skipped rather than passed on empty. Since windows are only known to be over when
+// a value arrives after them, each window is passed on when the first value of a later window arrives, or when the
+// source runs out. It panics when duration is not positive.
+func Window[T any](s *Stream[T], duration time.Duration, options ...WindowOption) *Stream[[]T] {
+	if duration <= 0 {
+		panic("stream: window duration must be positive")
+	}
+
+	applied := windowOptions{
+		clock: ClockFunc(time.Now),
+	}
+	for _, option := range options {
+		option(&applied)
+	}
+
+	return FromSeq(func(yield func([]T) bool) {
+		var (
+			window  []T
+			end     time.Time
+			started bool
+		)
+
+		for value := range s.seq {
+			now := applied.clock.Now()
+
+			switch {
+			case !started:
+				end = now.Add(duration)
+				started = true
+			case !now.Before(end):
+				if !yield(window) {
+					return
+				}
+				window = nil
+
+				// move on to the window holding now, which may be several windows later
+				end = end.Add(now.Sub(end).Truncate(duration) + duration)
+			}
+
+			window = append(window, value)
+		}
+
+		if len(window) > 0 {
+			yield(window)
+		}
+	})
+}
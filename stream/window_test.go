@@ -0,0 +1,218 @@
+package stream
+
+import (
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+// event is a value stamped with the number of seconds after the epoch that it arrives at.
+type event struct {
+	name    string
+	arrival int
+}
+
+// eventsWithClock returns a stream of the events along with a fake clock that reads the arrival of the latest event
+// to pass through the stream, as if each one had just arrived.
+func eventsWithClock(events ...event) (*Stream[event], Clock) {
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var now time.Time
+	clock := ClockFunc(func() time.Time {
+		return now
+	})
+
+	s := FromSlice(events).Peek(func(e event) {
+		now = epoch.Add(time.Duration(e.arrival) * time.Second)
+	})
+
+	return s, clock
+}
+
+// windowNames maps each window of events to the names of its events.
+func windowNames(s *Stream[[]event]) [][]string {
+	return Map(s, func(window []event) []string {
+		return Map(FromSlice(window), func(e event) string { return e.name }).ToSlice()
+	}).ToSlice()
+}
+
+func TestChunk(t *testing.T) {
+	type testScenario struct {
+		name           string
+		values         []int
+		n              int
+		expectedChunks [][]int
+	}
+
+	testScenarios := []testScenario{
+		{name: "chunk nothing", values: []int{}, n: 2, expectedChunks: [][]int{}},
+		{name: "chunk evenly", values: []int{1, 2, 3, 4}, n: 2, expectedChunks: [][]int{{1, 2}, {3, 4}}},
+		{name: "chunk with leftovers", values: []int{1, 2, 3, 4, 5}, n: 2, expectedChunks: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "chunk larger than the stream", values: []int{1, 2}, n: 5, expectedChunks: [][]int{{1, 2}}},
+		{name: "chunks of one", values: []int{1, 2}, n: 1, expectedChunks: [][]int{{1}, {2}}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			if actualChunks := Chunk(FromSlice(ts.values), ts.n).ToSlice(); !gocmp.Equal(actualChunks, ts.expectedChunks) {
+				test.ReportTestFailure(t, actualChunks, ts.expectedChunks)
+			}
+		})
+	}
+
+	t.Run("chunk an endless stream", func(t *testing.T) {
+		actualChunks := Chunk(FromSeq(naturals), 3).Limit(2).ToSlice()
+
+		expectedChunks := [][]int{{1, 2, 3}, {4, 5, 6}}
+		if !gocmp.Equal(actualChunks, expectedChunks) {
+			test.ReportTestFailure(t, actualChunks, expectedChunks)
+		}
+	})
+}
+
+func TestSliding(t *testing.T) {
+	type testScenario struct {
+		name            string
+		values          []int
+		size            int
+		step            int
+		expectedWindows [][]int
+	}
+
+	testScenarios := []testScenario{
+		{name: "slide over nothing", values: []int{}, size: 2, step: 1, expectedWindows: [][]int{}},
+		{name: "too few values for a window", values: []int{1, 2}, size: 3, step: 1, expectedWindows: [][]int{}},
+		{
+			name:            "overlapping windows",
+			values:          []int{1, 2, 3, 4, 5},
+			size:            3,
+			step:            1,
+			expectedWindows: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}},
+		},
+		{
+			name:            "windows overlapping by less",
+			values:          []int{1, 2, 3, 4, 5, 6, 7},
+			size:            3,
+			step:            2,
+			expectedWindows: [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6, 7}},
+		},
+		{
+			name:            "windows back to back",
+			values:          []int{1, 2, 3, 4, 5},
+			size:            2,
+			step:            2,
+			expectedWindows: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:            "windows with gaps",
+			values:          []int{1, 2, 3, 4, 5, 6, 7, 8},
+			size:            2,
+			step:            3,
+			expectedWindows: [][]int{{1, 2}, {4, 5}, {7, 8}},
+		},
+		{
+			name:   "windows larger than the ring queue's starting buffer",
+			values: FromSeq(naturals).Limit(20).ToSlice(),
+			size:   18,
+			step:   1,
+			expectedWindows: [][]int{
+				FromSeq(naturals).Limit(18).ToSlice(),
+				FromSeq(naturals).Skip(1).Limit(18).ToSlice(),
+				FromSeq(naturals).Skip(2).Limit(18).ToSlice(),
+			},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualWindows := Sliding(FromSlice(ts.values), ts.size, ts.step).ToSlice()
+			if !gocmp.Equal(actualWindows, ts.expectedWindows) {
+				test.ReportTestFailure(t, actualWindows, ts.expectedWindows)
+			}
+		})
+	}
+
+	t.Run("windows do not share memory", func(t *testing.T) {
+		windows := Sliding(Of(1, 2, 3), 2, 1).ToSlice()
+		windows[0][1] = 9
+
+		if windows[1][0] != 2 {
+			test.ReportTestFailure(t, windows[1][0], 2)
+		}
+	})
+}
+
+func TestWindow(t *testing.T) {
+	type testScenario struct {
+		name            string
+		events          []event
+		expectedWindows [][]string
+	}
+
+	testScenarios := []testScenario{
+		{name: "no events", events: []event{}, expectedWindows: [][]string{}},
+		{
+			name:            "events in one window",
+			events:          []event{{"a", 100}, {"b", 105}, {"c", 109}},
+			expectedWindows: [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:            "event on the edge of a window starts the next one",
+			events:          []event{{"a", 100}, {"b", 109}, {"c", 110}, {"d", 119}},
+			expectedWindows: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name:            "windows with no events are skipped",
+			events:          []event{{"a", 100}, {"b", 135}, {"c", 139}, {"d", 140}},
+			expectedWindows: [][]string{{"a"}, {"b", "c"}, {"d"}},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			s, clock := eventsWithClock(ts.events...)
+
+			actualWindows := windowNames(Window(s, 10*time.Second, WithClock(clock)))
+			if !gocmp.Equal(actualWindows, ts.expectedWindows) {
+				test.ReportTestFailure(t, actualWindows, ts.expectedWindows)
+			}
+		})
+	}
+
+	t.Run("system clock", func(t *testing.T) {
+		// with an hour long window, values read straight away all fall in the first window
+		actualWindows := Window(Of(1, 2, 3), time.Hour).ToSlice()
+
+		expectedWindows := [][]int{{1, 2, 3}}
+		if !gocmp.Equal(actualWindows, expectedWindows) {
+			test.ReportTestFailure(t, actualWindows, expectedWindows)
+		}
+	})
+}
+
+func TestWindowing_InvalidArgumentsPanic(t *testing.T) {
+	type testScenario struct {
+		name string
+		op   func()
+	}
+
+	testScenarios := []testScenario{
+		{name: "chunk of nothing", op: func() { Chunk(Of(1), 0) }},
+		{name: "sliding window of nothing", op: func() { Sliding(Of(1), 0, 1) }},
+		{name: "sliding window that never moves", op: func() { Sliding(Of(1), 1, 0) }},
+		{name: "window of no time", op: func() { Window(Of(1), 0) }},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					test.ReportTestFailure(t, r, "a panic for an invalid argument")
+				}
+			}()
+
+			ts.op()
+		})
+	}
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 )
 
@@ -23,3 +24,16 @@ func IsErrSame(actualErr, expectedErr error) bool {
 
 	return false
 }
+
+// RandomBytes returns n pseudo-random bytes that are the same on every run for the given seed. Fuzz targets use it to
+// seed their corpus with a long run of operations.
+func RandomBytes(seed int64, n int) []byte {
+	random := rand.New(rand.NewSource(seed))
+
+	values := make([]byte, n)
+	for i := range values {
+		values[i] = byte(random.Intn(256))
+	}
+
+	return values
+}
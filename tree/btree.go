@@ -0,0 +1,665 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Entry pairs a key with its value.
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// BTree defines a sorted map backed by a B-tree. Every node holds between degree - 1 and 2 * degree - 1 entries in a
+// flat slice, so a search only follows a handful of pointers and scans through memory that sits together. This tends
+// to suit large maps better than a binary search tree like the SortedMap. Gets, puts and deletes run in O(log n).
+//
+// Clone gives a copy of the tree in O(1) by sharing its nodes between both trees. Any node that is shared is copied the
+// first time either tree writes to it, which makes clones cheap snapshots.
+type BTree[K, V any] struct {
+	root    *bTreeNode[K, V]
+	length  int
+	degree  int
+	compare func(a, b K) int
+	owner   *bTreeOwner
+}
+
+// bTreeNode holds the sorted entries of a node and, unless it is a leaf, one more child than it has entries. The
+// children on either side of an entry hold the keys sorting before and after it.
+type bTreeNode[K, V any] struct {
+	entries  []Entry[K, V]
+	children []*bTreeNode[K, V]
+	owner    *bTreeOwner
+}
+
+// bTreeOwner marks which tree may write to a node in place. A node whose owner is not the tree's own is shared with a
+// clone and must be copied before it is written to. It is not empty so that every owner has a distinct address.
+type bTreeOwner struct {
+	_ byte
+}
+
+// NewBTree constructs an empty B-tree with the given degree ordered by the given compare function, which returns a
+// negative number when a sorts before b, a positive number when a sorts after b and zero when they are equal. A degree
+// below 2 panics.
+func NewBTree[K, V any](degree int, compare func(a, b K) int) *BTree[K, V] {
+	if degree < 2 {
+		panic("btree: degree must be at least 2")
+	}
+
+	return &BTree[K, V]{
+		degree:  degree,
+		compare: compare,
+		owner:   &bTreeOwner{},
+	}
+}
+
+// NewOrderedBTree constructs an empty B-tree with the given degree over an ordered key type in ascending order.
+func NewOrderedBTree[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	return NewBTree[K, V](degree, cmp.Compare[K])
+}
+
+// NewBTreeFromSorted constructs a B-tree holding the given entries, which must be sorted in strictly ascending order
+// by key. The tree is built bottom up in O(n) rather than through n puts. Entries out of order panic.
+func NewBTreeFromSorted[K, V any](degree int, compare func(a, b K) int, entries []Entry[K, V]) *BTree[K, V] {
+	t := NewBTree[K, V](degree, compare)
+
+	for i := 1; i < len(entries); i++ {
+		if compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			panic("btree: entries must be sorted in strictly ascending order by key")
+		}
+	}
+
+	if len(entries) == 0 {
+		return t
+	}
+
+	// maxEntries[h] is the most entries a subtree of height h can hold, (2 * degree)^h - 1
+	maxEntries := []int{0}
+	for maxEntries[len(maxEntries)-1] < len(entries) {
+		maxEntries = append(maxEntries, (maxEntries[len(maxEntries)-1]+1)*2*degree-1)
+	}
+
+	t.root = t.build(entries, maxEntries, len(maxEntries)-1, 2)
+	t.length = len(entries)
+
+	return t
+}
+
+// Length returns the number of entries in the BTree.
+func (t *BTree[K, V]) Length() int {
+	return t.length
+}
+
+// Empty removes all entries from the BTree and reduces its size to 0.
+func (t *BTree[K, V]) Empty() {
+	t.root = nil
+	t.length = 0
+}
+
+// Degree returns the degree of the BTree.
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Clone returns a copy of the BTree in O(1). The copy and the original share their nodes until either one writes to
+// them, so changes to one never show in the other. Clone must not be called while the tree is being written to.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	// both trees give up their claim on the current nodes, so the first write on either side copies what it touches
+	clone := *t
+	t.owner = &bTreeOwner{}
+	clone.owner = &bTreeOwner{}
+
+	return &clone
+}
+
+// Put stores the value under the key, replacing any value already stored under it.
+func (t *BTree[K, V]) Put(key K, value V) {
+	if t.root == nil {
+		t.root = t.newNode()
+		t.root.entries = append(t.root.entries, Entry[K, V]{Key: key, Value: value})
+		t.length++
+		return
+	}
+
+	t.root = t.mutable(t.root)
+	if len(t.root.entries) >= t.maxEntries() {
+		// split a full root so there is always room to push an entry up into it
+		entry, right := t.split(t.root, t.maxEntries()/2)
+
+		root := t.newNode()
+		root.entries = append(root.entries, entry)
+		root.children = append(root.children, t.root, right)
+		t.root = root
+	}
+
+	if t.insert(t.root, key, value) {
+		t.length++
+	}
+}
+
+// Get returns the value stored under the key and whether the key was found.
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	node := t.root
+	for node != nil {
+		i, found := t.find(node, key)
+		if found {
+			return node.entries[i].Value, true
+		}
+
+		if len(node.children) == 0 {
+			break
+		}
+		node = node.children[i]
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether the key is in the BTree.
+func (t *BTree[K, V]) Has(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete takes the key and its value out of the BTree, reporting whether the key was found.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+
+	t.root = t.mutable(t.root)
+	_, deleted := t.remove(t.root, key, false)
+
+	// the root shrinks away once its last entry has been merged down into its only child
+	if len(t.root.entries) == 0 {
+		if len(t.root.children) == 0 {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	if deleted {
+		t.length--
+	}
+
+	return deleted
+}
+
+// Min returns the smallest key and its value. The returned bool is false when the tree is empty.
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return zeroEntry[K, V]()
+	}
+
+	node := t.root
+	for len(node.children) > 0 {
+		node = node.children[0]
+	}
+
+	return node.entries[0].Key, node.entries[0].Value, true
+}
+
+// Max returns the largest key and its value. The returned bool is false when the tree is empty.
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return zeroEntry[K, V]()
+	}
+
+	node := t.root
+	for len(node.children) > 0 {
+		node = node.children[len(node.children)-1]
+	}
+
+	last := node.entries[len(node.entries)-1]
+	return last.Key, last.Value, true
+}
+
+// Floor returns the largest key less than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (t *BTree[K, V]) Floor(key K) (K, V, bool) {
+	return t.closestBelow(key, true)
+}
+
+// Lower returns the largest key strictly less than the given key, along with its value. The returned bool is false
+// when there is no such key.
+func (t *BTree[K, V]) Lower(key K) (K, V, bool) {
+	return t.closestBelow(key, false)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (t *BTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.closestAbove(key, true)
+}
+
+// Higher returns the smallest key strictly greater than the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (t *BTree[K, V]) Higher(key K) (K, V, bool) {
+	return t.closestAbove(key, false)
+}
+
+// Ascend calls fn for each key and value in ascending order, stopping early if fn returns false.
+func (t *BTree[K, V]) Ascend(fn func(key K, value V) bool) {
+	t.ascend(t.root, nil, nil, fn)
+}
+
+// Descend calls fn for each key and value in descending order, stopping early if fn returns false.
+func (t *BTree[K, V]) Descend(fn func(key K, value V) bool) {
+	t.descend(t.root, nil, nil, fn)
+}
+
+// AscendRange calls fn in ascending order for each key that is greater than or equal to from and less than to,
+// stopping early if fn returns false.
+func (t *BTree[K, V]) AscendRange(from, to K, fn func(key K, value V) bool) {
+	t.ascend(t.root, &from, &to, fn)
+}
+
+// DescendRange calls fn in descending order for each key that is greater than or equal to from and less than to,
+// stopping early if fn returns false.
+func (t *BTree[K, V]) DescendRange(from, to K, fn func(key K, value V) bool) {
+	t.descend(t.root, &from, &to, fn)
+}
+
+// All returns an iterator over the keys and values in ascending order, for use in a for range loop. The map must not
+// be changed while iterating over it.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return t.Ascend
+}
+
+// Backward returns an iterator over the keys and values in descending order. The map must not be changed while
+// iterating over it.
+func (t *BTree[K, V]) Backward() iter.Seq2[K, V] {
+	return t.Descend
+}
+
+// ascend walks the subtree in order, skipping any keys outside of [from, to) when the bounds are given. It returns
+// false once fn asks to stop or the walk passes to.
+func (t *BTree[K, V]) ascend(node *bTreeNode[K, V], from, to *K, fn func(key K, value V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	// every entry before start, along with the children to their left, sorts before from
+	start := 0
+	if from != nil {
+		start, _ = t.find(node, *from)
+	}
+
+	for i := start; i < len(node.entries); i++ {
+		if len(node.children) > 0 && !t.ascend(node.children[i], from, to, fn) {
+			return false
+		}
+
+		entry := node.entries[i]
+		if to != nil && t.compare(entry.Key, *to) >= 0 {
+			return false
+		}
+
+		if !fn(entry.Key, entry.Value) {
+			return false
+		}
+	}
+
+	if len(node.children) > 0 {
+		return t.ascend(node.children[len(node.entries)], from, to, fn)
+	}
+
+	return true
+}
+
+// descend walks the subtree in reverse order, skipping any keys outside of [from, to) when the bounds are given. It
+// returns false once fn asks to stop or the walk passes from.
+func (t *BTree[K, V]) descend(node *bTreeNode[K, V], from, to *K, fn func(key K, value V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	// every entry from end on, along with the children to their right, sorts at or after to
+	end := len(node.entries)
+	if to != nil {
+		end, _ = t.find(node, *to)
+	}
+
+	if len(node.children) > 0 && !t.descend(node.children[end], from, to, fn) {
+		return false
+	}
+
+	for i := end - 1; i >= 0; i-- {
+		entry := node.entries[i]
+		if from != nil && t.compare(entry.Key, *from) < 0 {
+			return false
+		}
+
+		if !fn(entry.Key, entry.Value) {
+			return false
+		}
+
+		if len(node.children) > 0 && !t.descend(node.children[i], from, to, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// closestBelow finds the largest key below the given key, including the key itself when inclusive is set.
+func (t *BTree[K, V]) closestBelow(key K, inclusive bool) (K, V, bool) {
+	var closest *Entry[K, V]
+
+	node := t.root
+	for node != nil {
+		i, found := t.find(node, key)
+		if found && inclusive {
+			return node.entries[i].Key, node.entries[i].Value, true
+		}
+
+		// every entry before i sorts before the key
+		if i > 0 {
+			closest = &node.entries[i-1]
+		}
+
+		if len(node.children) == 0 {
+			break
+		}
+		node = node.children[i]
+	}
+
+	if closest == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return closest.Key, closest.Value, true
+}
+
+// closestAbove finds the smallest key above the given key, including the key itself when inclusive is set.
+func (t *BTree[K, V]) closestAbove(key K, inclusive bool) (K, V, bool) {
+	var closest *Entry[K, V]
+
+	node := t.root
+	for node != nil {
+		i, found := t.find(node, key)
+		if found {
+			if inclusive {
+				return node.entries[i].Key, node.entries[i].Value, true
+			}
+			i++
+		}
+
+		// every entry from i on sorts after the key
+		if i < len(node.entries) {
+			closest = &node.entries[i]
+		}
+
+		if len(node.children) == 0 {
+			break
+		}
+		node = node.children[i]
+	}
+
+	if closest == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return closest.Key, closest.Value, true
+}
+
+// find returns the index of the first entry in the node whose key is not less than the given key, and whether that
+// entry holds the key itself.
+func (t *BTree[K, V]) find(node *bTreeNode[K, V], key K) (int, bool) {
+	low, high := 0, len(node.entries)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if t.compare(node.entries[mid].Key, key) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low, low < len(node.entries) && t.compare(node.entries[low].Key, key) == 0
+}
+
+// insert puts the key and value into the subtree of a node that is not full, splitting any full child on the way down.
+// It returns whether the key is new to the tree.
+func (t *BTree[K, V]) insert(node *bTreeNode[K, V], key K, value V) bool {
+	i, found := t.find(node, key)
+	if found {
+		node.entries[i].Value = value
+		return false
+	}
+
+	if len(node.children) == 0 {
+		node.entries = insertAt(node.entries, i, Entry[K, V]{Key: key, Value: value})
+		return true
+	}
+
+	if len(node.children[i].entries) >= t.maxEntries() {
+		t.splitChild(node, i)
+
+		// the middle entry of the child moved up to i, so the key may now belong to it or to its right
+		switch c := t.compare(key, node.entries[i].Key); {
+		case c > 0:
+			i++
+		case c == 0:
+			node.entries[i].Value = value
+			return false
+		}
+	}
+
+	return t.insert(t.mutableChild(node, i), key, value)
+}
+
+// remove takes the key, or the largest key when removeMax is set, out of the subtree of a node that holds more than
+// the fewest entries allowed. It tops up any child at the minimum on the way down so a removal never leaves one short.
+func (t *BTree[K, V]) remove(node *bTreeNode[K, V], key K, removeMax bool) (Entry[K, V], bool) {
+	var i int
+	var found bool
+	if removeMax {
+		i = len(node.entries)
+		found = len(node.children) == 0
+		if found {
+			i--
+		}
+	} else {
+		i, found = t.find(node, key)
+	}
+
+	if len(node.children) == 0 {
+		if !found {
+			return Entry[K, V]{}, false
+		}
+
+		removed := node.entries[i]
+		node.entries = removeAt(node.entries, i)
+		return removed, true
+	}
+
+	if len(node.children[i].entries) <= t.minEntries() {
+		t.growChild(node, i)
+
+		// growing the child moves entries around this node, so look for the key again
+		return t.remove(node, key, removeMax)
+	}
+
+	child := t.mutableChild(node, i)
+	if found {
+		// replace the entry with its predecessor, the largest entry of the subtree to its left
+		removed := node.entries[i]
+		node.entries[i], _ = t.remove(child, key, true)
+		return removed, true
+	}
+
+	return t.remove(child, key, removeMax)
+}
+
+// growChild makes sure the child at index i holds more than the fewest entries allowed, either by borrowing an entry
+// through the node from a sibling with entries to spare or by merging the child with a sibling.
+func (t *BTree[K, V]) growChild(node *bTreeNode[K, V], i int) {
+	switch {
+	case i > 0 && len(node.children[i-1].entries) > t.minEntries():
+		// rotate the last entry of the left sibling up into the node and the separating entry down into the child
+		child := t.mutableChild(node, i)
+		left := t.mutableChild(node, i-1)
+
+		child.entries = insertAt(child.entries, 0, node.entries[i-1])
+		node.entries[i-1] = left.entries[len(left.entries)-1]
+		left.entries = removeAt(left.entries, len(left.entries)-1)
+
+		if len(left.children) > 0 {
+			child.children = insertAt(child.children, 0, left.children[len(left.children)-1])
+			left.children = removeAt(left.children, len(left.children)-1)
+		}
+	case i < len(node.entries) && len(node.children[i+1].entries) > t.minEntries():
+		// rotate the first entry of the right sibling up into the node and the separating entry down into the child
+		child := t.mutableChild(node, i)
+		right := t.mutableChild(node, i+1)
+
+		child.entries = append(child.entries, node.entries[i])
+		node.entries[i] = right.entries[0]
+		right.entries = removeAt(right.entries, 0)
+
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+	default:
+		// merge the child with a sibling, pulling the separating entry down between them
+		if i >= len(node.entries) {
+			i--
+		}
+
+		child := t.mutableChild(node, i)
+		right := node.children[i+1]
+
+		child.entries = append(child.entries, node.entries[i])
+		child.entries = append(child.entries, right.entries...)
+		child.children = append(child.children, right.children...)
+
+		node.entries = removeAt(node.entries, i)
+		node.children = removeAt(node.children, i+1)
+	}
+}
+
+// splitChild splits the full child at index i in two, moving its middle entry up into the node.
+func (t *BTree[K, V]) splitChild(node *bTreeNode[K, V], i int) {
+	entry, right := t.split(t.mutableChild(node, i), t.maxEntries()/2)
+
+	node.entries = insertAt(node.entries, i, entry)
+	node.children = insertAt(node.children, i+1, right)
+}
+
+// split cuts the node at index i, keeping the entries before i and returning the entry at i along with a new node
+// holding everything after it.
+func (t *BTree[K, V]) split(node *bTreeNode[K, V], i int) (Entry[K, V], *bTreeNode[K, V]) {
+	entry := node.entries[i]
+
+	right := t.newNode()
+	right.entries = append(right.entries, node.entries[i+1:]...)
+	clear(node.entries[i:])
+	node.entries = node.entries[:i]
+
+	if len(node.children) > 0 {
+		right.children = append(right.children, node.children[i+1:]...)
+		clear(node.children[i+1:])
+		node.children = node.children[:i+1]
+	}
+
+	return entry, right
+}
+
+// build constructs a subtree of the given height holding the sorted entries, spreading them as evenly as possible
+// across at least minChildren children so that every node stays within the bounds of the degree.
+func (t *BTree[K, V]) build(entries []Entry[K, V], maxEntries []int, height, minChildren int) *bTreeNode[K, V] {
+	node := t.newNode()
+	if height == 1 {
+		node.entries = append(node.entries, entries...)
+		return node
+	}
+
+	// use as few children as will hold the entries, but never fewer than a node of this height needs
+	childCapacity := maxEntries[height-1] + 1
+	children := max((len(entries)+childCapacity)/childCapacity, minChildren)
+
+	// every child gets the same share of the entries, with the first few taking one more to use up the remainder
+	share := (len(entries) - children + 1) / children
+	remainder := (len(entries) - children + 1) % children
+
+	node.children = make([]*bTreeNode[K, V], 0, children)
+	for i := 0; i < children; i++ {
+		size := share
+		if i < remainder {
+			size++
+		}
+
+		node.children = append(node.children, t.build(entries[:size], maxEntries, height-1, t.degree))
+		entries = entries[size:]
+
+		if i < children-1 {
+			node.entries = append(node.entries, entries[0])
+			entries = entries[1:]
+		}
+	}
+
+	return node
+}
+
+// mutable returns the node if the tree owns it, or a copy of the node the tree owns otherwise.
+func (t *BTree[K, V]) mutable(node *bTreeNode[K, V]) *bTreeNode[K, V] {
+	if node.owner == t.owner {
+		return node
+	}
+
+	copied := t.newNode()
+	copied.entries = append(copied.entries, node.entries...)
+	if len(node.children) > 0 {
+		copied.children = append(make([]*bTreeNode[K, V], 0, cap(node.children)), node.children...)
+	}
+
+	return copied
+}
+
+// mutableChild makes the child at index i one the tree owns and returns it.
+func (t *BTree[K, V]) mutableChild(node *bTreeNode[K, V], i int) *bTreeNode[K, V] {
+	child := t.mutable(node.children[i])
+	node.children[i] = child
+
+	return child
+}
+
+func (t *BTree[K, V]) newNode() *bTreeNode[K, V] {
+	return &bTreeNode[K, V]{
+		entries: make([]Entry[K, V], 0, t.maxEntries()),
+		owner:   t.owner,
+	}
+}
+
+func (t *BTree[K, V]) maxEntries() int {
+	return 2*t.degree - 1
+}
+
+func (t *BTree[K, V]) minEntries() int {
+	return t.degree - 1
+}
+
+// insertAt inserts the value into the slice at index i.
+func insertAt[T any](s []T, i int, value T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = value
+
+	return s
+}
+
+// removeAt removes the value at index i from the slice, clearing the vacated slot so it can be garbage collected.
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+
+	var zero T
+	s[len(s)-1] = zero
+
+	return s[:len(s)-1]
+}
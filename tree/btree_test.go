@@ -0,0 +1,521 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/devsquared/gods/test"
+	gocmp "github.com/google/go-cmp/cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// sortedEntries builds entries for the keys 0 through n - 1, each mapping to ten times itself.
+func sortedEntries(n int) []Entry[int, int] {
+	entries := make([]Entry[int, int], n)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Key: i, Value: i * 10}
+	}
+
+	return entries
+}
+
+// bTreeShape returns the number of entries in every node, level by level from the root, which describes the shape of
+// the tree.
+func bTreeShape[K, V any](tree *BTree[K, V]) [][]int {
+	shape := make([][]int, 0)
+	level := make([]*bTreeNode[K, V], 0)
+	if tree.root != nil {
+		level = append(level, tree.root)
+	}
+
+	for len(level) > 0 {
+		sizes := make([]int, 0, len(level))
+		children := make([]*bTreeNode[K, V], 0)
+		for _, node := range level {
+			sizes = append(sizes, len(node.entries))
+			children = append(children, node.children...)
+		}
+
+		shape = append(shape, sizes)
+		level = children
+	}
+
+	return shape
+}
+
+func TestNewBTreePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			test.ReportTestFailure(t, r, "a panic for a degree below 2")
+		}
+	}()
+
+	NewOrderedBTree[int, int](1)
+}
+
+func TestBTree_PutGet(t *testing.T) {
+	type testScenario struct {
+		name          string
+		degree        int
+		keys          []int
+		lookup        int
+		expectedValue int
+		expectedFound bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "get from empty tree",
+			degree:        2,
+			keys:          []int{},
+			lookup:        1,
+			expectedFound: false,
+		},
+		{
+			name:          "get from single leaf",
+			degree:        3,
+			keys:          []int{3, 1, 2},
+			lookup:        2,
+			expectedValue: 20,
+			expectedFound: true,
+		},
+		{
+			name:          "get missing key after splits",
+			degree:        2,
+			keys:          []int{10, 20, 30, 40, 50, 60, 70},
+			lookup:        35,
+			expectedFound: false,
+		},
+		{
+			name:          "get key moved up by a split",
+			degree:        2,
+			keys:          []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			lookup:        4,
+			expectedValue: 40,
+			expectedFound: true,
+		},
+		{
+			name:          "put repeated keys",
+			degree:        2,
+			keys:          []int{5, 5, 4, 4, 3, 3, 2, 2, 1, 1},
+			lookup:        3,
+			expectedValue: 30,
+			expectedFound: true,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			tree := putKeys(NewOrderedBTree[int, int](ts.degree), ts.keys...)
+
+			actualValue, actualFound := tree.Get(ts.lookup)
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if actualFound != ts.expectedFound || tree.Has(ts.lookup) != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+
+			expectedKeys := slices.Clone(ts.keys)
+			slices.Sort(expectedKeys)
+			expectedKeys = slices.Compact(expectedKeys)
+			if actualKeys := collectKeys(tree.Ascend); !gocmp.Equal(actualKeys, expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, expectedKeys)
+			}
+		})
+	}
+
+	t.Run("put replaces the value of an existing key", func(t *testing.T) {
+		tree := putKeys(NewOrderedBTree[int, int](2), 1, 2, 3, 4, 5)
+		tree.Put(2, -2)
+
+		if actualValue, _ := tree.Get(2); actualValue != -2 {
+			test.ReportTestFailure(t, actualValue, -2)
+		}
+
+		if tree.Length() != 5 {
+			test.ReportTestFailure(t, tree.Length(), 5)
+		}
+	})
+}
+
+func TestBTree_Delete(t *testing.T) {
+	type testScenario struct {
+		name            string
+		keys            []int
+		toDelete        int
+		expectedDeleted bool
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:            "delete from empty tree",
+			keys:            []int{},
+			toDelete:        1,
+			expectedDeleted: false,
+		},
+		{
+			name:            "delete missing key",
+			keys:            []int{1, 2, 3, 4, 5, 6, 7},
+			toDelete:        8,
+			expectedDeleted: false,
+		},
+		{
+			name:            "delete only key",
+			keys:            []int{1},
+			toDelete:        1,
+			expectedDeleted: true,
+		},
+		{
+			name:            "delete from leaf",
+			keys:            []int{1, 2, 3, 4, 5, 6, 7},
+			toDelete:        7,
+			expectedDeleted: true,
+		},
+		{
+			name:            "delete from internal node",
+			keys:            []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			toDelete:        4,
+			expectedDeleted: true,
+		},
+		{
+			name:            "delete shrinks the root",
+			keys:            []int{1, 2, 3, 4},
+			toDelete:        1,
+			expectedDeleted: true,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			tree := putKeys(NewOrderedBTree[int, int](2), ts.keys...)
+
+			actualDeleted := tree.Delete(ts.toDelete)
+
+			if actualDeleted != ts.expectedDeleted {
+				test.ReportTestFailure(t, actualDeleted, ts.expectedDeleted)
+			}
+
+			expectedKeys := slices.DeleteFunc(slices.Clone(ts.keys), func(key int) bool { return key == ts.toDelete })
+			if actualKeys := collectKeys(tree.Ascend); !gocmp.Equal(actualKeys, expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, expectedKeys)
+			}
+		})
+	}
+
+	t.Run("delete every key", func(t *testing.T) {
+		tree := putKeys(NewOrderedBTree[int, int](3), rand.New(rand.NewSource(2)).Perm(100)...)
+		for i, key := range rand.New(rand.NewSource(3)).Perm(100) {
+			if !tree.Delete(key) || tree.Has(key) {
+				test.ReportTestFailure(t, tree.Has(key), false)
+			}
+
+			if tree.Length() != 99-i {
+				test.ReportTestFailure(t, tree.Length(), 99-i)
+			}
+		}
+
+		if tree.Length() != 0 || tree.root != nil {
+			test.ReportTestFailure(t, tree.Length(), 0)
+		}
+	})
+}
+
+func TestBTree_Navigation(t *testing.T) {
+	type testScenario struct {
+		name          string
+		find          func(tree *BTree[int, int]) (int, int, bool)
+		expectedKey   int
+		expectedFound bool
+	}
+
+	// spread the keys over several levels so lookups cross between nodes
+	tree := putKeys(NewOrderedBTree[int, int](2), 10, 20, 30, 40, 50, 60, 70, 80, 90)
+
+	testScenarios := []testScenario{
+		{name: "min", find: (*BTree[int, int]).Min, expectedKey: 10, expectedFound: true},
+		{name: "max", find: (*BTree[int, int]).Max, expectedKey: 90, expectedFound: true},
+		{name: "floor of present key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Floor(40) }, expectedKey: 40, expectedFound: true},
+		{name: "floor between keys", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Floor(45) }, expectedKey: 40, expectedFound: true},
+		{name: "floor below all keys", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Floor(5) }, expectedFound: false},
+		{name: "lower of present key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Lower(40) }, expectedKey: 30, expectedFound: true},
+		{name: "lower of smallest key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Lower(10) }, expectedFound: false},
+		{name: "ceiling of present key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Ceiling(60) }, expectedKey: 60, expectedFound: true},
+		{name: "ceiling between keys", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Ceiling(55) }, expectedKey: 60, expectedFound: true},
+		{name: "ceiling above all keys", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Ceiling(95) }, expectedFound: false},
+		{name: "higher of present key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Higher(20) }, expectedKey: 30, expectedFound: true},
+		{name: "higher of largest key", find: func(tree *BTree[int, int]) (int, int, bool) { return tree.Higher(90) }, expectedFound: false},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKey, actualValue, actualFound := ts.find(tree)
+
+			if actualKey != ts.expectedKey {
+				test.ReportTestFailure(t, actualKey, ts.expectedKey)
+			}
+
+			if actualValue != ts.expectedKey*10 {
+				test.ReportTestFailure(t, actualValue, ts.expectedKey*10)
+			}
+
+			if actualFound != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+		})
+	}
+
+	t.Run("min and max of empty tree", func(t *testing.T) {
+		empty := NewOrderedBTree[int, int](2)
+
+		if _, _, found := empty.Min(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+
+		if _, _, found := empty.Max(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+	})
+}
+
+func TestBTree_Iteration(t *testing.T) {
+	type testScenario struct {
+		name         string
+		iterate      func(tree *BTree[int, int]) func(fn func(key, value int) bool)
+		expectedKeys []int
+	}
+
+	tree := putKeys(NewOrderedBTree[int, int](2), 5, 1, 9, 3, 7, 2, 8, 4, 6)
+
+	testScenarios := []testScenario{
+		{
+			name:         "ascend",
+			iterate:      func(tree *BTree[int, int]) func(fn func(key, value int) bool) { return tree.Ascend },
+			expectedKeys: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name:         "descend",
+			iterate:      func(tree *BTree[int, int]) func(fn func(key, value int) bool) { return tree.Descend },
+			expectedKeys: []int{9, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name: "ascend range",
+			iterate: func(tree *BTree[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { tree.AscendRange(3, 8, fn) }
+			},
+			expectedKeys: []int{3, 4, 5, 6, 7},
+		},
+		{
+			name: "descend range",
+			iterate: func(tree *BTree[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { tree.DescendRange(3, 8, fn) }
+			},
+			expectedKeys: []int{7, 6, 5, 4, 3},
+		},
+		{
+			name: "range with no keys",
+			iterate: func(tree *BTree[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { tree.AscendRange(10, 20, fn) }
+			},
+			expectedKeys: []int{},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKeys := collectKeys(ts.iterate(tree))
+			if !gocmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+
+	t.Run("stop iterating early", func(t *testing.T) {
+		actualKeys := make([]int, 0)
+		tree.Ascend(func(key, _ int) bool {
+			actualKeys = append(actualKeys, key)
+			return key < 3
+		})
+
+		expectedKeys := []int{1, 2, 3}
+		if !gocmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+
+	t.Run("range over all and backward", func(t *testing.T) {
+		actualKeys := make([]int, 0)
+		for key := range tree.All() {
+			if key > 3 {
+				break
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys := []int{1, 2, 3}
+		if !gocmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+
+		actualKeys = actualKeys[:0]
+		for key, value := range tree.Backward() {
+			if value != key*10 {
+				test.ReportTestFailure(t, value, key*10)
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys = []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+		if !gocmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+}
+
+func TestNewBTreeFromSorted(t *testing.T) {
+	type testScenario struct {
+		name          string
+		degree        int
+		size          int
+		expectedShape [][]int
+	}
+
+	testScenarios := []testScenario{
+		{name: "load nothing", degree: 2, size: 0, expectedShape: [][]int{}},
+		{name: "load a single entry", degree: 2, size: 1, expectedShape: [][]int{{1}}},
+		{name: "load a full root", degree: 2, size: 3, expectedShape: [][]int{{3}}},
+		{name: "load one past a full root", degree: 2, size: 4, expectedShape: [][]int{{1}, {2, 1}}},
+		{name: "load two levels", degree: 2, size: 10, expectedShape: [][]int{{2}, {3, 3, 2}}},
+		{name: "load three levels", degree: 2, size: 16, expectedShape: [][]int{{1}, {2, 1}, {2, 2, 2, 3, 3}}},
+		{name: "load full nodes over three levels", degree: 2, size: 30, expectedShape: [][]int{{1}, {3, 3}, {3, 3, 3, 3, 3, 3, 3, 2}}},
+		{name: "load one past a full root of a wider tree", degree: 3, size: 6, expectedShape: [][]int{{1}, {3, 2}}},
+		{name: "load a wider tree", degree: 4, size: 50, expectedShape: [][]int{{6}, {7, 7, 6, 6, 6, 6, 6}}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			entries := sortedEntries(ts.size)
+			tree := NewBTreeFromSorted(ts.degree, cmp.Compare[int], entries)
+
+			if actualShape := bTreeShape(tree); !gocmp.Equal(actualShape, ts.expectedShape) {
+				test.ReportTestFailure(t, actualShape, ts.expectedShape)
+			}
+
+			actualEntries := make([]Entry[int, int], 0, ts.size)
+			tree.Ascend(func(key, value int) bool {
+				actualEntries = append(actualEntries, Entry[int, int]{Key: key, Value: value})
+				return true
+			})
+
+			if !gocmp.Equal(actualEntries, entries) {
+				test.ReportTestFailure(t, actualEntries, entries)
+			}
+		})
+	}
+
+	t.Run("the bulk loaded tree can still be written to", func(t *testing.T) {
+		tree := NewBTreeFromSorted(2, cmp.Compare[int], sortedEntries(50))
+		for key := 0; key < 50; key += 2 {
+			tree.Delete(key)
+			tree.Put(key+100, key)
+		}
+
+		if tree.Length() != 50 {
+			test.ReportTestFailure(t, tree.Length(), 50)
+		}
+	})
+
+	t.Run("unsorted entries panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				test.ReportTestFailure(t, r, "a panic for entries out of order")
+			}
+		}()
+
+		NewBTreeFromSorted(2, cmp.Compare[int], []Entry[int, int]{{Key: 1}, {Key: 1}})
+	})
+}
+
+func TestBTree_Clone(t *testing.T) {
+	original := putKeys(NewOrderedBTree[int, int](2), rand.New(rand.NewSource(4)).Perm(100)...)
+	clone := original.Clone()
+
+	// write to both trees so that each has to copy the nodes they share
+	for key := 0; key < 100; key += 3 {
+		original.Delete(key)
+		clone.Put(key, -key)
+	}
+	for key := 100; key < 120; key++ {
+		clone.Put(key, key)
+	}
+
+	for key := 0; key < 100; key++ {
+		originalValue, originalFound := original.Get(key)
+		if key%3 == 0 && originalFound || key%3 != 0 && originalValue != key*10 {
+			test.ReportTestFailure(t, originalValue, key*10)
+		}
+
+		cloneValue, _ := clone.Get(key)
+		if key%3 == 0 && cloneValue != -key || key%3 != 0 && cloneValue != key*10 {
+			test.ReportTestFailure(t, cloneValue, key*10)
+		}
+	}
+
+	if original.Has(110) {
+		test.ReportTestFailure(t, true, false)
+	}
+
+	t.Run("a clone of a clone is independent", func(t *testing.T) {
+		snapshot := clone.Clone()
+		clone.Empty()
+
+		if snapshot.Length() != 120 {
+			test.ReportTestFailure(t, snapshot.Length(), 120)
+		}
+	})
+}
+
+func FuzzBTree(f *testing.F) {
+	fuzzSortedMap(f, 2, func() *BTree[int, int] { return NewOrderedBTree[int, int](2) })
+}
+
+// BenchmarkSortedLookup compares looking up every key of a large map in the AVL backed SortedMap and a SkipList
+// against B-trees of a few degrees.
+func BenchmarkSortedLookup(b *testing.B) {
+	const size = 1 << 20
+	keys := rand.New(rand.NewSource(1)).Perm(size)
+
+	sortedMap := NewOrderedSortedMap[int, int]()
+	for _, key := range keys {
+		sortedMap.Put(key, key)
+	}
+
+	b.Run("sorted map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sortedMap.Get(keys[i%size])
+		}
+	})
+
+	skipList := NewOrderedSkipList[int, int](WithSeed(1))
+	for _, key := range keys {
+		skipList.Put(key, key)
+	}
+
+	b.Run("skip list", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			skipList.Get(keys[i%size])
+		}
+	})
+
+	for _, degree := range []int{8, 32, 128} {
+		tree := NewBTreeFromSorted(degree, cmp.Compare[int], sortedEntries(size))
+
+		b.Run(fmt.Sprintf("btree degree %d", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree.Get(keys[i%size])
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// skipListMaxLevel caps how many levels of links a node may have, enough for billions of entries.
+	skipListMaxLevel = 32
+	// skipListBranching is the inverse of the chance that a node reaching one level also reaches the next.
+	skipListBranching = 4
+)
+
+// SkipList defines a sorted map backed by a skip list. Every entry sits in a sorted linked list, and a random share of
+// the entries are also linked into sparser lists above it that let searches skip ahead, so gets, puts and deletes run
+// in O(log n) on average. It offers the same operations as the SortedMap, apart from rank and select and iterating in
+// descending order.
+//
+// A SkipList is safe for concurrent use. Writes take turns on a lock, but reads never take it: every link is read and
+// written atomically, and a write links a new node in from the bottom level up and unlinks a deleted one from the top
+// down, so a read always follows a sorted list. Reads therefore never wait on writes, and callbacks passed to Ascend
+// and AscendRange may call any method of the list, including writes. Iterating is weakly consistent: it sees each
+// entry that stays in the list throughout, and may or may not see entries put or deleted while it runs.
+type SkipList[K, V any] struct {
+	mu      sync.Mutex          // held by writes
+	head    *skipListNode[K, V] // sentinel node linked to the first node at every level
+	level   atomic.Int64        // number of levels in use
+	length  atomic.Int64
+	compare func(a, b K) int
+	random  *rand.Rand // only used by writes, under mu
+}
+
+// skipListNode holds a key and value along with the next node at each of its levels. The value is held behind a
+// pointer so that a put can replace it while reads are in progress.
+type skipListNode[K, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[skipListNode[K, V]]
+}
+
+// SkipListOption configures optional behaviour of a SkipList when it is constructed.
+type SkipListOption func(*skipListOptions)
+
+type skipListOptions struct {
+	seed int64
+}
+
+// WithSeed seeds the random numbers that pick the level of every node, so the shape of the list is the same on every
+// run. Without it, the list is seeded from the current time.
+func WithSeed(seed int64) SkipListOption {
+	return func(options *skipListOptions) {
+		options.seed = seed
+	}
+}
+
+// NewSkipList constructs an empty skip list ordered by the given compare function, which returns a negative number
+// when a sorts before b, a positive number when a sorts after b and zero when they are equal.
+func NewSkipList[K, V any](compare func(a, b K) int, options ...SkipListOption) *SkipList[K, V] {
+	listOptions := skipListOptions{
+		seed: time.Now().UnixNano(),
+	}
+	for _, option := range options {
+		option(&listOptions)
+	}
+
+	s := &SkipList[K, V]{
+		head: &skipListNode[K, V]{
+			next: make([]atomic.Pointer[skipListNode[K, V]], skipListMaxLevel),
+		},
+		compare: compare,
+		random:  rand.New(rand.NewSource(listOptions.seed)),
+	}
+	s.level.Store(1)
+
+	return s
+}
+
+// NewOrderedSkipList constructs an empty skip list over an ordered key type in ascending order.
+func NewOrderedSkipList[K cmp.Ordered, V any](options ...SkipListOption) *SkipList[K, V] {
+	return NewSkipList[K, V](cmp.Compare[K], options...)
+}
+
+// Length returns the number of entries in the SkipList.
+func (s *SkipList[K, V]) Length() int {
+	return int(s.length.Load())
+}
+
+// Empty removes all entries from the SkipList and reduces its size to 0.
+func (s *SkipList[K, V]) Empty() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.head.next {
+		s.head.next[i].Store(nil)
+	}
+	s.level.Store(1)
+	s.length.Store(0)
+}
+
+// Put stores the value under the key, replacing any value already stored under it.
+func (s *SkipList[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var previous [skipListMaxLevel]*skipListNode[K, V]
+	node := s.findPrevious(key, &previous)
+
+	if node != nil && s.compare(node.key, key) == 0 {
+		node.value.Store(&value)
+		return
+	}
+
+	level := s.randomLevel()
+	for i := int(s.level.Load()); i < level; i++ {
+		previous[i] = s.head
+	}
+
+	node = &skipListNode[K, V]{
+		key:  key,
+		next: make([]atomic.Pointer[skipListNode[K, V]], level),
+	}
+	node.value.Store(&value)
+
+	// link from the bottom up, so a node is in every level below one a read can find it on
+	for i := 0; i < level; i++ {
+		node.next[i].Store(previous[i].next[i].Load())
+		previous[i].next[i].Store(node)
+	}
+
+	if int64(level) > s.level.Load() {
+		s.level.Store(int64(level))
+	}
+	s.length.Add(1)
+}
+
+// Get returns the value stored under the key and whether the key was found.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	node := s.lastBefore(key, false).next[0].Load()
+	if node != nil && s.compare(node.key, key) == 0 {
+		return *node.value.Load(), true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether the key is in the SkipList.
+func (s *SkipList[K, V]) Has(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Delete takes the key and its value out of the SkipList, reporting whether the key was found.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var previous [skipListMaxLevel]*skipListNode[K, V]
+	node := s.findPrevious(key, &previous)
+
+	if node == nil || s.compare(node.key, key) != 0 {
+		return false
+	}
+
+	// unlink from the top down, the reverse of a put; the node keeps its own links so a read standing on it carries on
+	for i := len(node.next) - 1; i >= 0; i-- {
+		previous[i].next[i].Store(node.next[i].Load())
+	}
+
+	// drop any levels that no longer hold a node
+	for level := s.level.Load(); level > 1 && s.head.next[level-1].Load() == nil; level-- {
+		s.level.Store(level - 1)
+	}
+
+	s.length.Add(-1)
+	return true
+}
+
+// Min returns the smallest key and its value. The returned bool is false when the list is empty.
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	return s.entry(s.head.next[0].Load())
+}
+
+// Max returns the largest key and its value. The returned bool is false when the list is empty.
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	node := s.head
+	for i := s.level.Load() - 1; i >= 0; i-- {
+		for next := node.next[i].Load(); next != nil; next = node.next[i].Load() {
+			node = next
+		}
+	}
+
+	return s.entry(node)
+}
+
+// Floor returns the largest key less than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	return s.entry(s.lastBefore(key, true))
+}
+
+// Lower returns the largest key strictly less than the given key, along with its value. The returned bool is false
+// when there is no such key.
+func (s *SkipList[K, V]) Lower(key K) (K, V, bool) {
+	return s.entry(s.lastBefore(key, false))
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return s.entry(s.lastBefore(key, false).next[0].Load())
+}
+
+// Higher returns the smallest key strictly greater than the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (s *SkipList[K, V]) Higher(key K) (K, V, bool) {
+	return s.entry(s.lastBefore(key, true).next[0].Load())
+}
+
+// Ascend calls fn for each key and value in ascending order, stopping early if fn returns false. No lock is held while
+// fn runs, so fn may read from or write to the list.
+func (s *SkipList[K, V]) Ascend(fn func(key K, value V) bool) {
+	for node := s.head.next[0].Load(); node != nil; node = node.next[0].Load() {
+		if !fn(node.key, *node.value.Load()) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in ascending order, for use in a for range loop. Like Ascend, no
+// lock is held while the loop body runs.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return s.Ascend
+}
+
+// AscendRange calls fn in ascending order for each key that is greater than or equal to from and less than to,
+// stopping early if fn returns false. No lock is held while fn runs, so fn may read from or write to the list.
+func (s *SkipList[K, V]) AscendRange(from, to K, fn func(key K, value V) bool) {
+	for node := s.lastBefore(from, false).next[0].Load(); node != nil; node = node.next[0].Load() {
+		if s.compare(node.key, to) >= 0 || !fn(node.key, *node.value.Load()) {
+			return
+		}
+	}
+}
+
+// lastBefore returns the last node whose key is less than the given key, or less than or equal to it when inclusive
+// is set. The head is returned when there is no such node.
+func (s *SkipList[K, V]) lastBefore(key K, inclusive bool) *skipListNode[K, V] {
+	node := s.head
+	for i := s.level.Load() - 1; i >= 0; i-- {
+		for next := node.next[i].Load(); next != nil; next = node.next[i].Load() {
+			c := s.compare(next.key, key)
+			if c > 0 || c == 0 && !inclusive {
+				break
+			}
+			node = next
+		}
+	}
+
+	return node
+}
+
+// findPrevious fills previous with the last node before the key at every level in use and returns the first node at
+// or after the key, which is nil when every key is less than it. It must only be called by writes, under the lock.
+func (s *SkipList[K, V]) findPrevious(key K, previous *[skipListMaxLevel]*skipListNode[K, V]) *skipListNode[K, V] {
+	node := s.head
+	for i := s.level.Load() - 1; i >= 0; i-- {
+		for next := node.next[i].Load(); next != nil && s.compare(next.key, key) < 0; next = node.next[i].Load() {
+			node = next
+		}
+		previous[i] = node
+	}
+
+	return node.next[0].Load()
+}
+
+// randomLevel picks how many levels a new node is linked into, with each level after the first reached by one in
+// skipListBranching of the nodes on the level below.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.random.Intn(skipListBranching) == 0 {
+		level++
+	}
+
+	return level
+}
+
+// entry returns the key and value of the node, or zero values when the node is the head or missing.
+func (s *SkipList[K, V]) entry(node *skipListNode[K, V]) (K, V, bool) {
+	if node == nil || node == s.head {
+		return zeroEntry[K, V]()
+	}
+
+	return node.key, *node.value.Load(), true
+}
@@ -0,0 +1,473 @@
+package tree
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// skipListLevels returns the number of levels of every node in order, which describes the shape of the list.
+func skipListLevels[K, V any](s *SkipList[K, V]) []int {
+	levels := make([]int, 0, s.Length())
+	for node := s.head.next[0].Load(); node != nil; node = node.next[0].Load() {
+		levels = append(levels, len(node.next))
+	}
+
+	return levels
+}
+
+func TestSkipList_PutGet(t *testing.T) {
+	type testScenario struct {
+		name          string
+		keys          []int
+		lookup        int
+		expectedValue int
+		expectedFound bool
+		expectedKeys  []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:          "get from empty list",
+			keys:          []int{},
+			lookup:        1,
+			expectedFound: false,
+			expectedKeys:  []int{},
+		},
+		{
+			name:          "get present key",
+			keys:          []int{5, 3, 8, 1, 4},
+			lookup:        4,
+			expectedValue: 40,
+			expectedFound: true,
+			expectedKeys:  []int{1, 3, 4, 5, 8},
+		},
+		{
+			name:          "get missing key",
+			keys:          []int{5, 3, 8},
+			lookup:        4,
+			expectedFound: false,
+			expectedKeys:  []int{3, 5, 8},
+		},
+		{
+			name:          "get key past the end",
+			keys:          []int{5, 3, 8},
+			lookup:        9,
+			expectedFound: false,
+			expectedKeys:  []int{3, 5, 8},
+		},
+		{
+			name:          "put repeated keys keeps one entry",
+			keys:          []int{2, 1, 2, 1},
+			lookup:        2,
+			expectedValue: 20,
+			expectedFound: true,
+			expectedKeys:  []int{1, 2},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), ts.keys...)
+
+			actualValue, actualFound := s.Get(ts.lookup)
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if actualFound != ts.expectedFound || s.Has(ts.lookup) != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+
+			if actualKeys := collectKeys(s.Ascend); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+
+			if s.Length() != len(ts.expectedKeys) {
+				test.ReportTestFailure(t, s.Length(), len(ts.expectedKeys))
+			}
+		})
+	}
+
+	t.Run("put replaces the value of an existing key", func(t *testing.T) {
+		s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), 1, 2, 3)
+		s.Put(2, -2)
+
+		if actualValue, _ := s.Get(2); actualValue != -2 {
+			test.ReportTestFailure(t, actualValue, -2)
+		}
+	})
+}
+
+func TestSkipList_Delete(t *testing.T) {
+	type testScenario struct {
+		name            string
+		keys            []int
+		toDelete        int
+		expectedDeleted bool
+		expectedKeys    []int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:            "delete from empty list",
+			keys:            []int{},
+			toDelete:        1,
+			expectedDeleted: false,
+			expectedKeys:    []int{},
+		},
+		{
+			name:            "delete missing key",
+			keys:            []int{1, 2, 3},
+			toDelete:        4,
+			expectedDeleted: false,
+			expectedKeys:    []int{1, 2, 3},
+		},
+		{
+			name:            "delete first key",
+			keys:            []int{1, 2, 3},
+			toDelete:        1,
+			expectedDeleted: true,
+			expectedKeys:    []int{2, 3},
+		},
+		{
+			name:            "delete last key",
+			keys:            []int{1, 2, 3},
+			toDelete:        3,
+			expectedDeleted: true,
+			expectedKeys:    []int{1, 2},
+		},
+		{
+			name:            "delete only key",
+			keys:            []int{1},
+			toDelete:        1,
+			expectedDeleted: true,
+			expectedKeys:    []int{},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), ts.keys...)
+
+			actualDeleted := s.Delete(ts.toDelete)
+
+			if actualDeleted != ts.expectedDeleted {
+				test.ReportTestFailure(t, actualDeleted, ts.expectedDeleted)
+			}
+
+			if actualKeys := collectKeys(s.Ascend); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+
+	t.Run("delete every key drops every level", func(t *testing.T) {
+		s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), rand.New(rand.NewSource(2)).Perm(500)...)
+		for _, key := range rand.New(rand.NewSource(3)).Perm(500) {
+			s.Delete(key)
+		}
+
+		if s.level.Load() != 1 || s.Length() != 0 {
+			test.ReportTestFailure(t, s.level.Load(), 1)
+		}
+	})
+}
+
+func TestSkipList_Navigation(t *testing.T) {
+	type testScenario struct {
+		name          string
+		find          func(s *SkipList[int, int]) (int, int, bool)
+		expectedKey   int
+		expectedFound bool
+	}
+
+	s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), 10, 20, 30, 40, 50)
+
+	testScenarios := []testScenario{
+		{name: "min", find: (*SkipList[int, int]).Min, expectedKey: 10, expectedFound: true},
+		{name: "max", find: (*SkipList[int, int]).Max, expectedKey: 50, expectedFound: true},
+		{name: "floor of present key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Floor(30) }, expectedKey: 30, expectedFound: true},
+		{name: "floor between keys", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Floor(35) }, expectedKey: 30, expectedFound: true},
+		{name: "floor below all keys", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Floor(5) }, expectedFound: false},
+		{name: "lower of present key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Lower(30) }, expectedKey: 20, expectedFound: true},
+		{name: "lower of smallest key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Lower(10) }, expectedFound: false},
+		{name: "ceiling of present key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Ceiling(30) }, expectedKey: 30, expectedFound: true},
+		{name: "ceiling between keys", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Ceiling(35) }, expectedKey: 40, expectedFound: true},
+		{name: "ceiling above all keys", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Ceiling(55) }, expectedFound: false},
+		{name: "higher of present key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Higher(30) }, expectedKey: 40, expectedFound: true},
+		{name: "higher of largest key", find: func(s *SkipList[int, int]) (int, int, bool) { return s.Higher(50) }, expectedFound: false},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKey, actualValue, actualFound := ts.find(s)
+
+			if actualKey != ts.expectedKey {
+				test.ReportTestFailure(t, actualKey, ts.expectedKey)
+			}
+
+			if actualValue != ts.expectedKey*10 {
+				test.ReportTestFailure(t, actualValue, ts.expectedKey*10)
+			}
+
+			if actualFound != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+		})
+	}
+
+	t.Run("min and max of empty list", func(t *testing.T) {
+		empty := NewOrderedSkipList[int, int]()
+
+		if _, _, found := empty.Min(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+
+		if _, _, found := empty.Max(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+	})
+}
+
+func TestSkipList_AscendRange(t *testing.T) {
+	type testScenario struct {
+		name         string
+		from         int
+		to           int
+		expectedKeys []int
+	}
+
+	s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), 5, 1, 9, 3, 7, 2, 8)
+
+	testScenarios := []testScenario{
+		{name: "range inside the keys", from: 3, to: 8, expectedKeys: []int{3, 5, 7}},
+		{name: "range starting between keys", from: 4, to: 9, expectedKeys: []int{5, 7, 8}},
+		{name: "range covering every key", from: 0, to: 10, expectedKeys: []int{1, 2, 3, 5, 7, 8, 9}},
+		{name: "range with no keys", from: 10, to: 20, expectedKeys: []int{}},
+		{name: "empty range", from: 5, to: 5, expectedKeys: []int{}},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKeys := collectKeys(func(fn func(key, value int) bool) { s.AscendRange(ts.from, ts.to, fn) })
+			if !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+
+	t.Run("stop iterating early", func(t *testing.T) {
+		actualKeys := make([]int, 0)
+		s.AscendRange(2, 9, func(key, _ int) bool {
+			actualKeys = append(actualKeys, key)
+			return key < 5
+		})
+
+		expectedKeys := []int{2, 3, 5}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+}
+
+func TestSkipList_All(t *testing.T) {
+	s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), 5, 1, 9, 3, 7, 2, 8)
+
+	actualKeys := make([]int, 0)
+	for key, value := range s.All() {
+		if value != key*10 {
+			test.ReportTestFailure(t, value, key*10)
+		}
+		actualKeys = append(actualKeys, key)
+	}
+
+	expectedKeys := []int{1, 2, 3, 5, 7, 8, 9}
+	if !cmp.Equal(actualKeys, expectedKeys) {
+		test.ReportTestFailure(t, actualKeys, expectedKeys)
+	}
+
+	// no lock is held while the loop body runs, so it can write to the list it is iterating
+	for key := range s.All() {
+		if key == 3 {
+			s.Put(4, 40)
+			s.Delete(7)
+		}
+	}
+
+	if !s.Has(4) || s.Has(7) {
+		test.ReportTestFailure(t, s.Has(4), true)
+	}
+}
+
+func TestSkipList_CustomComparator(t *testing.T) {
+	// order by descending length, then alphabetically
+	s := NewSkipList[string, int](func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return strings.Compare(a, b)
+	}, WithSeed(1))
+
+	for i, key := range []string{"fig", "banana", "kiwi", "apple", "date"} {
+		s.Put(key, i)
+	}
+
+	expectedKeys := []string{"banana", "apple", "date", "kiwi", "fig"}
+	actualKeys := make([]string, 0)
+	s.Ascend(func(key string, _ int) bool {
+		actualKeys = append(actualKeys, key)
+		return true
+	})
+
+	if !cmp.Equal(actualKeys, expectedKeys) {
+		test.ReportTestFailure(t, actualKeys, expectedKeys)
+	}
+}
+
+func TestSkipList_WithSeed(t *testing.T) {
+	keys := rand.New(rand.NewSource(4)).Perm(200)
+
+	first := NewOrderedSkipList[int, int](WithSeed(42))
+	second := NewOrderedSkipList[int, int](WithSeed(42))
+	for _, key := range keys {
+		first.Put(key, key)
+		second.Put(key, key)
+	}
+
+	// lists with the same seed and the same puts share the same shape
+	if firstLevels, secondLevels := skipListLevels(first), skipListLevels(second); !cmp.Equal(firstLevels, secondLevels) {
+		test.ReportTestFailure(t, firstLevels, secondLevels)
+	}
+
+	if first.level.Load() == 1 {
+		test.ReportTestFailure(t, first.level.Load(), "more than one level")
+	}
+}
+
+func FuzzSkipList(f *testing.F) {
+	fuzzSortedMap(f, 5, func() *SkipList[int, int] { return NewOrderedSkipList[int, int](WithSeed(5)) })
+}
+
+func TestSkipList_ConcurrentReads(t *testing.T) {
+	s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), rand.New(rand.NewSource(6)).Perm(1000)...)
+
+	var wg sync.WaitGroup
+
+	// readers only ever look up the even keys, which the writer leaves alone
+	for reader := 0; reader < 8; reader++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for key := 0; key < 1000; key += 2 {
+				if actualValue, found := s.Get(key); !found || actualValue != key*10 {
+					test.ReportTestFailure(t, actualValue, key*10)
+				}
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for key := 1; key < 1000; key += 2 {
+			s.Delete(key)
+			s.Put(key+1000, key)
+		}
+	}()
+
+	wg.Wait()
+
+	// lookups walk down from the top level, so they only find every key when each level is linked correctly
+	for key := 0; key < 2000; key++ {
+		expectedFound := key < 1000 && key%2 == 0 || key > 1000 && key%2 == 1
+		if _, actualFound := s.Get(key); actualFound != expectedFound {
+			test.ReportTestFailure(t, actualFound, expectedFound)
+		}
+	}
+
+	if s.Length() != 1000 {
+		test.ReportTestFailure(t, s.Length(), 1000)
+	}
+}
+
+func TestSkipList_ReadDuringWrite(t *testing.T) {
+	s := putKeys(NewOrderedSkipList[int, int](WithSeed(1)), 1, 2, 3)
+
+	// a write that starts while a callback is running must not wait for it to return, and reads made from the
+	// callback must not wait for the write
+	s.Ascend(func(key, _ int) bool {
+		written := make(chan struct{})
+		go func() {
+			defer close(written)
+			s.Put(key+10, key)
+		}()
+
+		select {
+		case <-written:
+		case <-time.After(time.Second):
+			test.ReportTestFailure(t, "a write waiting on the callback", "the write to finish")
+			return false
+		}
+
+		if value, found := s.Get(key + 10); !found || value != key {
+			test.ReportTestFailure(t, value, key)
+		}
+		return s.Length() < 6
+	})
+
+	if actualKeys := collectKeys(s.Ascend); !cmp.Equal(actualKeys, []int{1, 2, 3, 11, 12, 13}) {
+		test.ReportTestFailure(t, actualKeys, []int{1, 2, 3, 11, 12, 13})
+	}
+}
+
+// BenchmarkSortedPut compares putting a large number of keys in random order into the AVL backed SortedMap, a B-tree
+// and a SkipList.
+func BenchmarkSortedPut(b *testing.B) {
+	const size = 1 << 20
+	keys := rand.New(rand.NewSource(1)).Perm(size)
+
+	b.Run("sorted map", func(b *testing.B) {
+		m := NewOrderedSortedMap[int, int]()
+		for i := 0; i < b.N; i++ {
+			m.Put(keys[i%size], i)
+		}
+	})
+
+	b.Run("btree degree 32", func(b *testing.B) {
+		tree := NewOrderedBTree[int, int](32)
+		for i := 0; i < b.N; i++ {
+			tree.Put(keys[i%size], i)
+		}
+	})
+
+	b.Run("skip list", func(b *testing.B) {
+		s := NewOrderedSkipList[int, int](WithSeed(1))
+		for i := 0; i < b.N; i++ {
+			s.Put(keys[i%size], i)
+		}
+	})
+}
+
+// BenchmarkSkipList_ParallelGet measures lookups made from many goroutines at once, which never take a lock.
+func BenchmarkSkipList_ParallelGet(b *testing.B) {
+	const size = 1 << 16
+	s := NewOrderedSkipList[int, int](WithSeed(1))
+	for _, key := range rand.New(rand.NewSource(1)).Perm(size) {
+		s.Put(key, key)
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		key := 0
+		for pb.Next() {
+			s.Get(key % size)
+			key += 7
+		}
+	})
+}
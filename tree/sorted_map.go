@@ -0,0 +1,449 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedMap defines a map that keeps its keys sorted. It is backed by an AVL tree, a binary search tree that keeps
+// the heights of every node's two subtrees within one of each other, so lookups, puts and deletes run in O(log n).
+// Every node also tracks the size of its subtree, which allows keys to be found by rank in O(log n) too.
+type SortedMap[K, V any] struct {
+	root    *avlNode[K, V]
+	compare func(a, b K) int
+}
+
+// avlNode holds a key and value along with its subtrees, its height and the number of nodes in its subtree.
+type avlNode[K, V any] struct {
+	key    K
+	value  V
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+	height int
+	size   int
+}
+
+// NewSortedMap constructs an empty sorted map ordered by the given compare function, which returns a negative number
+// when a sorts before b, a positive number when a sorts after b and zero when they are equal.
+func NewSortedMap[K, V any](compare func(a, b K) int) *SortedMap[K, V] {
+	return &SortedMap[K, V]{
+		compare: compare,
+	}
+}
+
+// NewOrderedSortedMap constructs an empty sorted map over an ordered key type in ascending order.
+func NewOrderedSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return NewSortedMap[K, V](cmp.Compare[K])
+}
+
+// Length returns the number of entries in the SortedMap.
+func (m *SortedMap[K, V]) Length() int {
+	return size(m.root)
+}
+
+// Empty removes all entries from the SortedMap and reduces its size to 0.
+func (m *SortedMap[K, V]) Empty() {
+	m.root = nil
+}
+
+// Put stores the value under the key, replacing any value already stored under it.
+func (m *SortedMap[K, V]) Put(key K, value V) {
+	m.root = m.put(m.root, key, value)
+}
+
+// Get returns the value stored under the key and whether the key was found.
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	node := m.root
+	for node != nil {
+		switch c := m.compare(key, node.key); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether the key is in the SortedMap.
+func (m *SortedMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete takes the key and its value out of the SortedMap, reporting whether the key was found.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	m.root = m.delete(m.root, key, &deleted)
+
+	return deleted
+}
+
+// Min returns the smallest key and its value. The returned bool is false when the map is empty.
+func (m *SortedMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return entry(minNode(m.root))
+}
+
+// Max returns the largest key and its value. The returned bool is false when the map is empty.
+func (m *SortedMap[K, V]) Max() (K, V, bool) {
+	if m.root == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return entry(maxNode(m.root))
+}
+
+// Floor returns the largest key less than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (m *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.closestBelow(key, true)
+}
+
+// Lower returns the largest key strictly less than the given key, along with its value. The returned bool is false
+// when there is no such key.
+func (m *SortedMap[K, V]) Lower(key K) (K, V, bool) {
+	return m.closestBelow(key, false)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (m *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.closestAbove(key, true)
+}
+
+// Higher returns the smallest key strictly greater than the given key, along with its value. The returned bool is
+// false when there is no such key.
+func (m *SortedMap[K, V]) Higher(key K) (K, V, bool) {
+	return m.closestAbove(key, false)
+}
+
+// Rank returns the number of keys in the SortedMap that are strictly less than the given key. For a key in the map,
+// this is its zero-based position in sorted order.
+func (m *SortedMap[K, V]) Rank(key K) int {
+	rank := 0
+
+	node := m.root
+	for node != nil {
+		if m.compare(key, node.key) <= 0 {
+			node = node.left
+			continue
+		}
+
+		rank += size(node.left) + 1
+		node = node.right
+	}
+
+	return rank
+}
+
+// Select returns the key at the given zero-based position in sorted order, along with its value. The returned bool is
+// false when the rank is out of bounds.
+func (m *SortedMap[K, V]) Select(rank int) (K, V, bool) {
+	if rank < 0 || rank >= m.Length() {
+		return zeroEntry[K, V]()
+	}
+
+	node := m.root
+	for {
+		leftSize := size(node.left)
+		switch {
+		case rank < leftSize:
+			node = node.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			node = node.right
+		default:
+			return entry(node)
+		}
+	}
+}
+
+// Ascend calls fn for each key and value in ascending order, stopping early if fn returns false.
+func (m *SortedMap[K, V]) Ascend(fn func(key K, value V) bool) {
+	m.ascend(m.root, nil, nil, fn)
+}
+
+// Descend calls fn for each key and value in descending order, stopping early if fn returns false.
+func (m *SortedMap[K, V]) Descend(fn func(key K, value V) bool) {
+	m.descend(m.root, nil, nil, fn)
+}
+
+// AscendRange calls fn in ascending order for each key that is greater than or equal to from and less than to,
+// stopping early if fn returns false.
+func (m *SortedMap[K, V]) AscendRange(from, to K, fn func(key K, value V) bool) {
+	m.ascend(m.root, &from, &to, fn)
+}
+
+// DescendRange calls fn in descending order for each key that is greater than or equal to from and less than to,
+// stopping early if fn returns false.
+func (m *SortedMap[K, V]) DescendRange(from, to K, fn func(key K, value V) bool) {
+	m.descend(m.root, &from, &to, fn)
+}
+
+// All returns an iterator over the keys and values in ascending order, for use in a for range loop. The map must not
+// be changed while iterating over it.
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Ascend
+}
+
+// Backward returns an iterator over the keys and values in descending order. The map must not be changed while
+// iterating over it.
+func (m *SortedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return m.Descend
+}
+
+// ascend walks the subtree in order, skipping any keys outside of [from, to) when the bounds are given. It returns
+// false once fn asks to stop.
+func (m *SortedMap[K, V]) ascend(node *avlNode[K, V], from, to *K, fn func(key K, value V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	aboveFrom := from == nil || m.compare(node.key, *from) >= 0
+	belowTo := to == nil || m.compare(node.key, *to) < 0
+
+	if aboveFrom && !m.ascend(node.left, from, to, fn) {
+		return false
+	}
+
+	if aboveFrom && belowTo && !fn(node.key, node.value) {
+		return false
+	}
+
+	if belowTo {
+		return m.ascend(node.right, from, to, fn)
+	}
+
+	return true
+}
+
+// descend walks the subtree in reverse order, skipping any keys outside of [from, to) when the bounds are given. It
+// returns false once fn asks to stop.
+func (m *SortedMap[K, V]) descend(node *avlNode[K, V], from, to *K, fn func(key K, value V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	aboveFrom := from == nil || m.compare(node.key, *from) >= 0
+	belowTo := to == nil || m.compare(node.key, *to) < 0
+
+	if belowTo && !m.descend(node.right, from, to, fn) {
+		return false
+	}
+
+	if aboveFrom && belowTo && !fn(node.key, node.value) {
+		return false
+	}
+
+	if aboveFrom {
+		return m.descend(node.left, from, to, fn)
+	}
+
+	return true
+}
+
+// closestBelow finds the largest key below the given key, including the key itself when inclusive is set.
+func (m *SortedMap[K, V]) closestBelow(key K, inclusive bool) (K, V, bool) {
+	var closest *avlNode[K, V]
+
+	node := m.root
+	for node != nil {
+		c := m.compare(node.key, key)
+		if c < 0 || (inclusive && c == 0) {
+			closest = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+
+	if closest == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return entry(closest)
+}
+
+// closestAbove finds the smallest key above the given key, including the key itself when inclusive is set.
+func (m *SortedMap[K, V]) closestAbove(key K, inclusive bool) (K, V, bool) {
+	var closest *avlNode[K, V]
+
+	node := m.root
+	for node != nil {
+		c := m.compare(node.key, key)
+		if c > 0 || (inclusive && c == 0) {
+			closest = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	if closest == nil {
+		return zeroEntry[K, V]()
+	}
+
+	return entry(closest)
+}
+
+func (m *SortedMap[K, V]) put(node *avlNode[K, V], key K, value V) *avlNode[K, V] {
+	if node == nil {
+		return &avlNode[K, V]{
+			key:    key,
+			value:  value,
+			height: 1,
+			size:   1,
+		}
+	}
+
+	switch c := m.compare(key, node.key); {
+	case c < 0:
+		node.left = m.put(node.left, key, value)
+	case c > 0:
+		node.right = m.put(node.right, key, value)
+	default:
+		node.value = value
+		return node
+	}
+
+	return rebalance(node)
+}
+
+func (m *SortedMap[K, V]) delete(node *avlNode[K, V], key K, deleted *bool) *avlNode[K, V] {
+	if node == nil {
+		return nil
+	}
+
+	switch c := m.compare(key, node.key); {
+	case c < 0:
+		node.left = m.delete(node.left, key, deleted)
+	case c > 0:
+		node.right = m.delete(node.right, key, deleted)
+	default:
+		*deleted = true
+
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		// replace the node with its successor, the smallest node of its right subtree
+		successor := minNode(node.right)
+		successor.right = deleteMin(node.right)
+		successor.left = node.left
+		node = successor
+	}
+
+	return rebalance(node)
+}
+
+// deleteMin takes the smallest node out of the subtree and returns the new root of the subtree.
+func deleteMin[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	if node.left == nil {
+		return node.right
+	}
+
+	node.left = deleteMin(node.left)
+	return rebalance(node)
+}
+
+// rebalance updates the node's height and size and rotates it if its subtrees' heights differ by more than one. It
+// returns the new root of the subtree.
+func rebalance[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	update(node)
+
+	switch balance := height(node.left) - height(node.right); {
+	case balance > 1:
+		if height(node.left.left) < height(node.left.right) {
+			node.left = rotateLeft(node.left)
+		}
+		return rotateRight(node)
+	case balance < -1:
+		if height(node.right.right) < height(node.right.left) {
+			node.right = rotateRight(node.right)
+		}
+		return rotateLeft(node)
+	default:
+		return node
+	}
+}
+
+func rotateLeft[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+
+	update(node)
+	update(pivot)
+
+	return pivot
+}
+
+func rotateRight[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+
+	update(node)
+	update(pivot)
+
+	return pivot
+}
+
+// update recomputes the height and size of the node from its subtrees.
+func update[K, V any](node *avlNode[K, V]) {
+	node.height = max(height(node.left), height(node.right)) + 1
+	node.size = size(node.left) + size(node.right) + 1
+}
+
+func height[K, V any](node *avlNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.height
+}
+
+func size[K, V any](node *avlNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.size
+}
+
+func minNode[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	for node.left != nil {
+		node = node.left
+	}
+
+	return node
+}
+
+func maxNode[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	for node.right != nil {
+		node = node.right
+	}
+
+	return node
+}
+
+func entry[K, V any](node *avlNode[K, V]) (K, V, bool) {
+	return node.key, node.value, true
+}
+
+func zeroEntry[K, V any]() (K, V, bool) {
+	var zeroKey K
+	var zeroValue V
+	return zeroKey, zeroValue, false
+}
@@ -0,0 +1,518 @@
+package tree
+
+import (
+	"github.com/devsquared/gods/test"
+	"github.com/google/go-cmp/cmp"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// sortedMap is the part of SortedMap, BTree and SkipList that the shared test helpers drive.
+type sortedMap interface {
+	Put(key, value int)
+	Get(key int) (int, bool)
+	Delete(key int) bool
+	Length() int
+	Floor(key int) (int, int, bool)
+	Ceiling(key int) (int, int, bool)
+	Ascend(fn func(key, value int) bool)
+}
+
+// putKeys puts every key into the map, mapped to ten times itself, and returns the map.
+func putKeys[M sortedMap](m M, keys ...int) M {
+	for _, key := range keys {
+		m.Put(key, key*10)
+	}
+
+	return m
+}
+
+// collectKeys gathers the keys visited by one of the SortedMap's iteration methods.
+func collectKeys(iterate func(fn func(key, value int) bool)) []int {
+	keys := make([]int, 0)
+	iterate(func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+func TestSortedMap_PutGet(t *testing.T) {
+	type testScenario struct {
+		name          string
+		keys          []int
+		lookup        int
+		expectedValue int
+		expectedFound bool
+		expectedKeys  []int
+		// expectedHeight is the height of the tree, which stays within the AVL bound of about 1.44 log n
+		expectedHeight int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:           "get from empty map",
+			keys:           []int{},
+			lookup:         1,
+			expectedFound:  false,
+			expectedKeys:   []int{},
+			expectedHeight: 0,
+		},
+		{
+			name:           "get present key",
+			keys:           []int{5, 3, 8, 1, 4},
+			lookup:         4,
+			expectedValue:  40,
+			expectedFound:  true,
+			expectedKeys:   []int{1, 3, 4, 5, 8},
+			expectedHeight: 3,
+		},
+		{
+			name:           "get missing key",
+			keys:           []int{5, 3, 8},
+			lookup:         4,
+			expectedFound:  false,
+			expectedKeys:   []int{3, 5, 8},
+			expectedHeight: 2,
+		},
+		{
+			name:           "put ascending keys stays balanced",
+			keys:           []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			lookup:         9,
+			expectedValue:  90,
+			expectedFound:  true,
+			expectedKeys:   []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expectedHeight: 4,
+		},
+		{
+			name:           "put repeated keys keeps one entry",
+			keys:           []int{2, 1, 2, 1},
+			lookup:         2,
+			expectedValue:  20,
+			expectedFound:  true,
+			expectedKeys:   []int{1, 2},
+			expectedHeight: 2,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			m := putKeys(NewOrderedSortedMap[int, int](), ts.keys...)
+
+			actualValue, actualFound := m.Get(ts.lookup)
+			if actualValue != ts.expectedValue {
+				test.ReportTestFailure(t, actualValue, ts.expectedValue)
+			}
+
+			if actualFound != ts.expectedFound || m.Has(ts.lookup) != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+
+			if actualKeys := collectKeys(m.Ascend); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+
+			if m.Length() != len(ts.expectedKeys) {
+				test.ReportTestFailure(t, m.Length(), len(ts.expectedKeys))
+			}
+
+			if actualHeight := height(m.root); actualHeight != ts.expectedHeight {
+				test.ReportTestFailure(t, actualHeight, ts.expectedHeight)
+			}
+		})
+	}
+
+	t.Run("put replaces the value of an existing key", func(t *testing.T) {
+		m := putKeys(NewOrderedSortedMap[int, int](), 1, 2, 3)
+		m.Put(2, -2)
+
+		if actualValue, _ := m.Get(2); actualValue != -2 {
+			test.ReportTestFailure(t, actualValue, -2)
+		}
+	})
+}
+
+func TestSortedMap_Delete(t *testing.T) {
+	type testScenario struct {
+		name            string
+		keys            []int
+		toDelete        int
+		expectedDeleted bool
+		expectedKeys    []int
+		expectedHeight  int
+	}
+
+	testScenarios := []testScenario{
+		{
+			name:            "delete from empty map",
+			keys:            []int{},
+			toDelete:        1,
+			expectedDeleted: false,
+			expectedKeys:    []int{},
+			expectedHeight:  0,
+		},
+		{
+			name:            "delete missing key",
+			keys:            []int{1, 2, 3},
+			toDelete:        4,
+			expectedDeleted: false,
+			expectedKeys:    []int{1, 2, 3},
+			expectedHeight:  2,
+		},
+		{
+			name:            "delete leaf",
+			keys:            []int{2, 1, 3},
+			toDelete:        3,
+			expectedDeleted: true,
+			expectedKeys:    []int{1, 2},
+			expectedHeight:  2,
+		},
+		{
+			name:            "delete root with two children",
+			keys:            []int{4, 2, 6, 1, 3, 5, 7},
+			toDelete:        4,
+			expectedDeleted: true,
+			expectedKeys:    []int{1, 2, 3, 5, 6, 7},
+			expectedHeight:  3,
+		},
+		{
+			name:            "delete node with one child",
+			keys:            []int{4, 2, 6, 7},
+			toDelete:        6,
+			expectedDeleted: true,
+			expectedKeys:    []int{2, 4, 7},
+			expectedHeight:  2,
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			m := putKeys(NewOrderedSortedMap[int, int](), ts.keys...)
+
+			actualDeleted := m.Delete(ts.toDelete)
+
+			if actualDeleted != ts.expectedDeleted {
+				test.ReportTestFailure(t, actualDeleted, ts.expectedDeleted)
+			}
+
+			if actualKeys := collectKeys(m.Ascend); !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+
+			if m.Has(ts.toDelete) {
+				test.ReportTestFailure(t, true, false)
+			}
+
+			if actualHeight := height(m.root); actualHeight != ts.expectedHeight {
+				test.ReportTestFailure(t, actualHeight, ts.expectedHeight)
+			}
+		})
+	}
+}
+
+func TestSortedMap_Navigation(t *testing.T) {
+	type testScenario struct {
+		name          string
+		find          func(m *SortedMap[int, int]) (int, int, bool)
+		expectedKey   int
+		expectedFound bool
+	}
+
+	m := putKeys(NewOrderedSortedMap[int, int](), 10, 20, 30, 40, 50)
+
+	testScenarios := []testScenario{
+		{name: "min", find: (*SortedMap[int, int]).Min, expectedKey: 10, expectedFound: true},
+		{name: "max", find: (*SortedMap[int, int]).Max, expectedKey: 50, expectedFound: true},
+		{name: "floor of present key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Floor(30) }, expectedKey: 30, expectedFound: true},
+		{name: "floor between keys", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Floor(35) }, expectedKey: 30, expectedFound: true},
+		{name: "floor below all keys", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Floor(5) }, expectedFound: false},
+		{name: "lower of present key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Lower(30) }, expectedKey: 20, expectedFound: true},
+		{name: "lower of smallest key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Lower(10) }, expectedFound: false},
+		{name: "ceiling of present key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Ceiling(30) }, expectedKey: 30, expectedFound: true},
+		{name: "ceiling between keys", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Ceiling(35) }, expectedKey: 40, expectedFound: true},
+		{name: "ceiling above all keys", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Ceiling(55) }, expectedFound: false},
+		{name: "higher of present key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Higher(30) }, expectedKey: 40, expectedFound: true},
+		{name: "higher of largest key", find: func(m *SortedMap[int, int]) (int, int, bool) { return m.Higher(50) }, expectedFound: false},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKey, actualValue, actualFound := ts.find(m)
+
+			if actualKey != ts.expectedKey {
+				test.ReportTestFailure(t, actualKey, ts.expectedKey)
+			}
+
+			if actualValue != ts.expectedKey*10 {
+				test.ReportTestFailure(t, actualValue, ts.expectedKey*10)
+			}
+
+			if actualFound != ts.expectedFound {
+				test.ReportTestFailure(t, actualFound, ts.expectedFound)
+			}
+		})
+	}
+
+	t.Run("min and max of empty map", func(t *testing.T) {
+		empty := NewOrderedSortedMap[int, int]()
+
+		if _, _, found := empty.Min(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+
+		if _, _, found := empty.Max(); found {
+			test.ReportTestFailure(t, found, false)
+		}
+	})
+}
+
+func TestSortedMap_RankSelect(t *testing.T) {
+	type testScenario struct {
+		name         string
+		key          int
+		expectedRank int
+	}
+
+	m := putKeys(NewOrderedSortedMap[int, int](), 10, 20, 30, 40, 50)
+
+	testScenarios := []testScenario{
+		{name: "rank below all keys", key: 0, expectedRank: 0},
+		{name: "rank of smallest key", key: 10, expectedRank: 0},
+		{name: "rank between keys", key: 25, expectedRank: 2},
+		{name: "rank of largest key", key: 50, expectedRank: 4},
+		{name: "rank above all keys", key: 99, expectedRank: 5},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualRank := m.Rank(ts.key)
+			if actualRank != ts.expectedRank {
+				test.ReportTestFailure(t, actualRank, ts.expectedRank)
+			}
+		})
+	}
+
+	t.Run("select every rank", func(t *testing.T) {
+		for rank, expectedKey := range []int{10, 20, 30, 40, 50} {
+			actualKey, _, found := m.Select(rank)
+			if !found || actualKey != expectedKey {
+				test.ReportTestFailure(t, actualKey, expectedKey)
+			}
+		}
+	})
+
+	t.Run("rank and select after deletes", func(t *testing.T) {
+		thinned := putKeys(NewOrderedSortedMap[int, int](), 8, 3, 5, 1, 9, 2, 7, 4, 6)
+		for _, key := range []int{2, 4, 6, 8} {
+			thinned.Delete(key)
+		}
+
+		for rank, expectedKey := range []int{1, 3, 5, 7, 9} {
+			if actualRank := thinned.Rank(expectedKey); actualRank != rank {
+				test.ReportTestFailure(t, actualRank, rank)
+			}
+
+			if actualKey, _, _ := thinned.Select(rank); actualKey != expectedKey {
+				test.ReportTestFailure(t, actualKey, expectedKey)
+			}
+		}
+	})
+
+	t.Run("select out of bounds", func(t *testing.T) {
+		for _, rank := range []int{-1, 5} {
+			if _, _, found := m.Select(rank); found {
+				test.ReportTestFailure(t, found, false)
+			}
+		}
+	})
+}
+
+func TestSortedMap_Iteration(t *testing.T) {
+	type testScenario struct {
+		name         string
+		iterate      func(m *SortedMap[int, int]) func(fn func(key, value int) bool)
+		expectedKeys []int
+	}
+
+	m := putKeys(NewOrderedSortedMap[int, int](), 5, 1, 9, 3, 7, 2, 8)
+
+	testScenarios := []testScenario{
+		{
+			name:         "ascend",
+			iterate:      func(m *SortedMap[int, int]) func(fn func(key, value int) bool) { return m.Ascend },
+			expectedKeys: []int{1, 2, 3, 5, 7, 8, 9},
+		},
+		{
+			name:         "descend",
+			iterate:      func(m *SortedMap[int, int]) func(fn func(key, value int) bool) { return m.Descend },
+			expectedKeys: []int{9, 8, 7, 5, 3, 2, 1},
+		},
+		{
+			name: "ascend range",
+			iterate: func(m *SortedMap[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { m.AscendRange(3, 8, fn) }
+			},
+			expectedKeys: []int{3, 5, 7},
+		},
+		{
+			name: "descend range",
+			iterate: func(m *SortedMap[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { m.DescendRange(3, 8, fn) }
+			},
+			expectedKeys: []int{7, 5, 3},
+		},
+		{
+			name: "range with no keys",
+			iterate: func(m *SortedMap[int, int]) func(fn func(key, value int) bool) {
+				return func(fn func(key, value int) bool) { m.AscendRange(10, 20, fn) }
+			},
+			expectedKeys: []int{},
+		},
+	}
+
+	for _, ts := range testScenarios {
+		t.Run(ts.name, func(t *testing.T) {
+			actualKeys := collectKeys(ts.iterate(m))
+			if !cmp.Equal(actualKeys, ts.expectedKeys) {
+				test.ReportTestFailure(t, actualKeys, ts.expectedKeys)
+			}
+		})
+	}
+
+	t.Run("stop iterating early", func(t *testing.T) {
+		actualKeys := make([]int, 0)
+		m.Descend(func(key, _ int) bool {
+			actualKeys = append(actualKeys, key)
+			return key > 7
+		})
+
+		expectedKeys := []int{9, 8, 7}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+
+	t.Run("range over all and backward", func(t *testing.T) {
+		actualKeys := make([]int, 0)
+		for key, value := range m.All() {
+			if value != key*10 {
+				test.ReportTestFailure(t, value, key*10)
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys := []int{1, 2, 3, 5, 7, 8, 9}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+
+		actualKeys = actualKeys[:0]
+		for key := range m.Backward() {
+			if key < 7 {
+				break
+			}
+			actualKeys = append(actualKeys, key)
+		}
+
+		expectedKeys = []int{9, 8, 7}
+		if !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+	})
+}
+
+func TestSortedMap_CustomComparator(t *testing.T) {
+	// order case-insensitively, so keys differing only in case are the same key
+	m := NewSortedMap[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	m.Put("banana", 1)
+	m.Put("Apple", 2)
+	m.Put("cherry", 3)
+	m.Put("APPLE", 4)
+
+	expectedKeys := []string{"Apple", "banana", "cherry"}
+	actualKeys := make([]string, 0)
+	m.Ascend(func(key string, _ int) bool {
+		actualKeys = append(actualKeys, key)
+		return true
+	})
+
+	if !cmp.Equal(actualKeys, expectedKeys) {
+		test.ReportTestFailure(t, actualKeys, expectedKeys)
+	}
+
+	if actualValue, _ := m.Get("apple"); actualValue != 4 {
+		test.ReportTestFailure(t, actualValue, 4)
+	}
+}
+
+func FuzzSortedMap(f *testing.F) {
+	fuzzSortedMap(f, 5, NewOrderedSortedMap[int, int])
+}
+
+// fuzzSortedMap runs the fuzz input against a map as a mix of puts and deletes, then checks its keys, values and
+// neighbours against a plain map holding the same entries.
+func fuzzSortedMap[M sortedMap](f *testing.F, seed int64, newMap func() M) {
+	f.Add([]byte{})
+	f.Add([]byte{15, 6, 24, 3, 9, 21, 27, 4, 16})
+	f.Add(test.RandomBytes(seed, 2000))
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		// each byte deletes or puts by its remainder and picks a key with the rest
+		m := newMap()
+		expected := make(map[int]int)
+
+		for i, op := range ops {
+			key := int(op / 3)
+			if op%3 == 0 {
+				_, expectedDeleted := expected[key]
+				if actualDeleted := m.Delete(key); actualDeleted != expectedDeleted {
+					test.ReportTestFailure(t, actualDeleted, expectedDeleted)
+				}
+				delete(expected, key)
+				continue
+			}
+
+			m.Put(key, i+1)
+			expected[key] = i + 1
+		}
+
+		expectedKeys := slices.AppendSeq(make([]int, 0, len(expected)), maps.Keys(expected))
+		slices.Sort(expectedKeys)
+		if actualKeys := collectKeys(m.Ascend); !cmp.Equal(actualKeys, expectedKeys) {
+			test.ReportTestFailure(t, actualKeys, expectedKeys)
+		}
+
+		if m.Length() != len(expected) {
+			test.ReportTestFailure(t, m.Length(), len(expected))
+		}
+
+		for key := range 87 {
+			if actualValue, _ := m.Get(key); actualValue != expected[key] {
+				test.ReportTestFailure(t, actualValue, expected[key])
+			}
+
+			// the ceiling is the first key at or after the probe and the floor the last key at or before it
+			ceiling, found := slices.BinarySearch(expectedKeys, key)
+			floor := ceiling - 1
+			if found {
+				floor = ceiling
+			}
+
+			actualKey, _, actualFound := m.Ceiling(key)
+			if actualFound != (ceiling < len(expectedKeys)) || actualFound && actualKey != expectedKeys[ceiling] {
+				test.ReportTestFailure(t, actualKey, expectedKeys[ceiling:])
+			}
+
+			actualKey, _, actualFound = m.Floor(key)
+			if actualFound != (floor >= 0) || actualFound && actualKey != expectedKeys[floor] {
+				test.ReportTestFailure(t, actualKey, expectedKeys[:floor+1])
+			}
+		}
+	})
+}